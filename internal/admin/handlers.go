@@ -1,15 +1,18 @@
 package admin
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/centrifugal/centrifugo/internal/api"
 	"github.com/centrifugal/centrifugo/internal/middleware"
 
 	"github.com/centrifugal/centrifuge"
 	"github.com/gorilla/securecookie"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog/log"
 )
 
@@ -32,6 +35,24 @@ type Config struct {
 	// Secret is a secret to generate auth token for admin requests.
 	Secret string
 
+	// Users is an optional list of admin users with individual passwords
+	// and roles. When set users log in with username and password pair.
+	// Password option still works for login without username and gives
+	// full access.
+	Users []User
+
+	// MetricsInterval is an interval to sample internal counters for admin
+	// metrics endpoint. Zero value disables admin metrics.
+	MetricsInterval time.Duration
+
+	// MetricsWindow is a number of metrics points to keep.
+	MetricsWindow int
+
+	// ConfigDump when set returns effective configuration of node with
+	// secrets redacted. It's served over config endpoint for admin users
+	// with full access.
+	ConfigDump func() ([]byte, error)
+
 	// Insecure turns on insecure mode for admin endpoints - no auth
 	// required to connect to web interface and for requests to admin API.
 	// Admin resources must be protected by firewall rules in production when
@@ -40,11 +61,36 @@ type Config struct {
 	Insecure bool
 }
 
+// Role defines which admin API methods are available for admin user.
+type Role string
+
+const (
+	// RoleFull gives access to all admin API methods.
+	RoleFull Role = "full"
+	// RoleReadOnly only gives access to admin API methods which do not
+	// change server state: info, channels, presence, presence_stats, history.
+	RoleReadOnly Role = "read_only"
+)
+
+// User is an admin user.
+type User struct {
+	// Username is a unique name of admin user used for login.
+	Username string `mapstructure:"username" json:"username"`
+	// Password of admin user.
+	Password string `mapstructure:"password" json:"password"`
+	// PasswordFile is a path to a file with admin user password. Used
+	// only when Password not set.
+	PasswordFile string `mapstructure:"password_file" json:"password_file"`
+	// Role of admin user.
+	Role Role `mapstructure:"role" json:"role"`
+}
+
 // Handler handles admin web interface endpoints.
 type Handler struct {
-	mux    *http.ServeMux
-	node   *centrifuge.Node
-	config Config
+	mux     *http.ServeMux
+	node    *centrifuge.Node
+	config  Config
+	metrics *metricsWindow
 }
 
 // NewHandler creates new Handler.
@@ -56,7 +102,18 @@ func NewHandler(n *centrifuge.Node, apiExecutor *api.Executor, c Config) *Handle
 	mux := http.NewServeMux()
 	prefix := strings.TrimRight(h.config.Prefix, "/")
 	mux.Handle(prefix+"/admin/auth", middleware.Post(http.HandlerFunc(h.authHandler)))
-	mux.Handle(prefix+"/admin/api", middleware.Post(h.adminSecureTokenAuth(api.NewHandler(n, apiExecutor, api.Config{}))))
+	fullAPIHandler := api.NewHandler(n, apiExecutor, api.Config{})
+	readOnlyAPIHandler := api.NewHandler(n, apiExecutor, api.Config{ReadOnly: true})
+	mux.Handle(prefix+"/admin/api", middleware.Post(h.adminSecureTokenAuth(fullAPIHandler, readOnlyAPIHandler)))
+	if c.MetricsInterval > 0 && c.MetricsWindow > 0 {
+		h.metrics = newMetricsWindow(prometheus.DefaultGatherer, n.Hub().NumClients, c.MetricsWindow)
+		go h.metrics.run(c.MetricsInterval, n.NotifyShutdown())
+		metricsHandler := http.HandlerFunc(h.metricsHandler)
+		mux.Handle(prefix+"/admin/metrics", h.adminSecureTokenAuth(metricsHandler, metricsHandler))
+	}
+	if c.ConfigDump != nil {
+		mux.Handle(prefix+"/admin/config", h.adminSecureTokenAuth(http.HandlerFunc(h.configHandler), http.HandlerFunc(forbiddenHandler)))
+	}
 	webPrefix := prefix + "/"
 	if c.WebPath != "" {
 		mux.Handle(webPrefix, http.StripPrefix(webPrefix, http.FileServer(http.Dir(c.WebPath))))
@@ -71,8 +128,34 @@ func (s *Handler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	s.mux.ServeHTTP(rw, r)
 }
 
-// adminSecureTokenAuth ...
-func (s *Handler) adminSecureTokenAuth(h http.Handler) http.Handler {
+func (s *Handler) findUser(username string) (User, bool) {
+	for _, u := range s.config.Users {
+		if u.Username == username {
+			return u, true
+		}
+	}
+	return User{}, false
+}
+
+// configHandler writes effective configuration of node.
+func (s *Handler) configHandler(w http.ResponseWriter, _ *http.Request) {
+	data, err := s.config.ConfigDump()
+	if err != nil {
+		log.Error().Msgf("error dumping configuration: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func forbiddenHandler(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusForbidden)
+}
+
+// adminSecureTokenAuth checks admin token and passes request to handler
+// corresponding to admin user role.
+func (s *Handler) adminSecureTokenAuth(h http.Handler, readOnly http.Handler) http.Handler {
 
 	secret := s.config.Secret
 	insecure := s.config.Insecure
@@ -97,18 +180,38 @@ func (s *Handler) adminSecureTokenAuth(h http.Handler) http.Handler {
 			return
 		}
 		authMethod := strings.ToLower(parts[0])
+		if authMethod != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
 
-		if authMethod != "token" || !checkSecureAdminToken(secret, parts[1]) {
+		username, ok := checkSecureAdminToken(secret, parts[1])
+		if !ok {
 			w.WriteHeader(http.StatusUnauthorized)
 			return
 		}
 
+		if username != "" {
+			// Look at current user role – so user token becomes invalid
+			// as soon as user removed from configuration.
+			user, ok := s.findUser(username)
+			if !ok {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if user.Role != RoleFull {
+				readOnly.ServeHTTP(w, r)
+				return
+			}
+		}
+
 		h.ServeHTTP(w, r)
 	})
 }
 
 // authHandler allows to get admin web interface token.
 func (s *Handler) authHandler(w http.ResponseWriter, r *http.Request) {
+	formUsername := r.FormValue("username")
 	formPassword := r.FormValue("password")
 
 	insecure := s.config.Insecure
@@ -126,29 +229,52 @@ func (s *Handler) authHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if formUsername != "" {
+		if secret == "" {
+			log.Error().Msg("admin_secret must be set in configuration")
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		user, ok := s.findUser(formUsername)
+		if !ok || user.Password == "" || !passwordsEqual(formPassword, user.Password) {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		s.writeToken(w, formUsername)
+		return
+	}
+
 	if password == "" || secret == "" {
 		log.Error().Msg("admin_password and admin_secret must be set in configuration")
 		http.Error(w, "Bad Request", http.StatusBadRequest)
 		return
 	}
 
-	if formPassword == password {
-		w.Header().Set("Content-Type", "application/json")
-		token, err := generateSecureAdminToken(secret)
-		if err != nil {
-			log.Error().Msgf("error generating admin token: %v", err)
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-			return
-		}
-		resp := map[string]string{
-			"token": token,
-		}
-		_ = json.NewEncoder(w).Encode(resp)
+	if passwordsEqual(formPassword, password) {
+		s.writeToken(w, "")
 		return
 	}
 	http.Error(w, "Bad Request", http.StatusBadRequest)
 }
 
+func passwordsEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+func (s *Handler) writeToken(w http.ResponseWriter, username string) {
+	w.Header().Set("Content-Type", "application/json")
+	token, err := generateSecureAdminToken(s.config.Secret, username)
+	if err != nil {
+		log.Error().Msgf("error generating admin token: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	resp := map[string]string{
+		"token": token,
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 const (
 	// AdminTokenKey is a key for admin authorization token.
 	secureAdminTokenKey = "token"
@@ -156,22 +282,31 @@ const (
 	secureAdminTokenValue = "authorized"
 )
 
-// generateSecureAdminToken generates admin authentication token.
-func generateSecureAdminToken(secret string) (string, error) {
+// generateSecureAdminToken generates admin authentication token. Empty
+// username means token for login with admin password.
+func generateSecureAdminToken(secret string, username string) (string, error) {
 	s := securecookie.New([]byte(secret), nil)
-	return s.Encode(secureAdminTokenKey, secureAdminTokenValue)
+	val := secureAdminTokenValue
+	if username != "" {
+		val += ":" + username
+	}
+	return s.Encode(secureAdminTokenKey, val)
 }
 
-// checkSecureAdminToken checks admin connection token which Centrifugo returns after admin login.
-func checkSecureAdminToken(secret string, token string) bool {
+// checkSecureAdminToken checks admin connection token which Centrifugo returns
+// after admin login. It returns username token was issued for.
+func checkSecureAdminToken(secret string, token string) (string, bool) {
 	s := securecookie.New([]byte(secret), nil)
 	var val string
 	err := s.Decode(secureAdminTokenKey, token, &val)
 	if err != nil {
-		return false
+		return "", false
+	}
+	if val == secureAdminTokenValue {
+		return "", true
 	}
-	if val != secureAdminTokenValue {
-		return false
+	if !strings.HasPrefix(val, secureAdminTokenValue+":") {
+		return "", false
 	}
-	return true
+	return strings.TrimPrefix(val, secureAdminTokenValue+":"), true
 }
@@ -0,0 +1,226 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/api"
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+)
+
+func nodeWithMemoryEngine() *centrifuge.Node {
+	n, err := centrifuge.New(centrifuge.DefaultConfig)
+	if err != nil {
+		panic(err)
+	}
+	err = n.Run()
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func testHandler() *Handler {
+	n := nodeWithMemoryEngine()
+	ruleContainer := rule.NewContainer(rule.DefaultConfig)
+	executor := api.NewExecutor(n, ruleContainer, "admin")
+	return NewHandler(n, executor, Config{
+		Password: "password",
+		Secret:   "secret",
+		Users: []User{
+			{Username: "alice", Password: "alice_password", Role: RoleFull},
+			{Username: "bob", Password: "bob_password", Role: RoleReadOnly},
+		},
+	})
+}
+
+func login(t *testing.T, h *Handler, username, password string) (string, int) {
+	form := url.Values{}
+	if username != "" {
+		form.Set("username", username)
+	}
+	form.Set("password", password)
+	req := httptest.NewRequest(http.MethodPost, "/admin/auth", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		return "", rec.Code
+	}
+	var resp map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp["token"], rec.Code
+}
+
+func callAPI(h *Handler, token string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/admin/api", strings.NewReader(body))
+	req.Header.Set("Authorization", "token "+token)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthHandler(t *testing.T) {
+	h := testHandler()
+
+	_, code := login(t, h, "", "wrong")
+	require.Equal(t, http.StatusBadRequest, code)
+	_, code = login(t, h, "alice", "bob_password")
+	require.Equal(t, http.StatusBadRequest, code)
+	_, code = login(t, h, "unknown", "password")
+	require.Equal(t, http.StatusBadRequest, code)
+
+	token, code := login(t, h, "", "password")
+	require.Equal(t, http.StatusOK, code)
+	require.NotEmpty(t, token)
+	token, code = login(t, h, "alice", "alice_password")
+	require.Equal(t, http.StatusOK, code)
+	require.NotEmpty(t, token)
+}
+
+func TestAdminUserRoles(t *testing.T) {
+	h := testHandler()
+
+	rec := callAPI(h, "invalid", `{"method": "info"}`)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	token, _ := login(t, h, "bob", "bob_password")
+	rec = callAPI(h, token, `{"method": "info"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, rec.Body.String(), `"error"`)
+	rec = callAPI(h, token, `{"method": "disconnect", "params": {"user": "test"}}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"code":103`)
+
+	token, _ = login(t, h, "alice", "alice_password")
+	rec = callAPI(h, token, `{"method": "disconnect", "params": {"user": "test"}}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, rec.Body.String(), `"error"`)
+
+	token, _ = login(t, h, "", "password")
+	rec = callAPI(h, token, `{"method": "disconnect", "params": {"user": "test"}}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotContains(t, rec.Body.String(), `"error"`)
+
+	// Removed user loses access.
+	token, _ = login(t, h, "bob", "bob_password")
+	h.config.Users = h.config.Users[:1]
+	rec = callAPI(h, token, `{"method": "info"}`)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestMetricsWindow(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	messages := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "test", Subsystem: "node", Name: "messages_sent_count",
+	}, []string{"type"})
+	replyErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "test", Subsystem: "client", Name: "num_reply_errors",
+	}, []string{"method", "code"})
+	apiCommands := prometheus.NewSummaryVec(prometheus.SummaryOpts{
+		Namespace: "test", Subsystem: "api", Name: "command_duration_seconds",
+	}, []string{"protocol", "method"})
+	registry.MustRegister(messages, replyErrors, apiCommands)
+
+	numClients := 0
+	w := newMetricsWindow(registry, func() int { return numClients }, 3)
+	require.Len(t, w.points(), 0)
+
+	now := time.Now()
+	require.NoError(t, w.sample(now))
+	require.Len(t, w.points(), 0)
+
+	numClients = 5
+	messages.WithLabelValues("publication").Add(20)
+	replyErrors.WithLabelValues("subscribe", "103").Add(2)
+	for i := 0; i < 4; i++ {
+		apiCommands.WithLabelValues("http", "publish").Observe(0.01)
+	}
+	apiCommands.WithLabelValues("http", "info").Observe(0.01)
+	require.NoError(t, w.sample(now.Add(2*time.Second)))
+
+	points := w.points()
+	require.Len(t, points, 1)
+	require.Equal(t, 5, points[0].Connections)
+	require.Equal(t, float64(10), points[0].MessagesPerSec)
+	require.Equal(t, float64(1), points[0].ErrorsPerSec)
+	require.Equal(t, float64(2), points[0].PublishesPerSec)
+
+	// No activity – zero rates.
+	require.NoError(t, w.sample(now.Add(3*time.Second)))
+	points = w.points()
+	require.Len(t, points, 2)
+	require.Equal(t, float64(0), points[1].MessagesPerSec)
+
+	// Window keeps only configured number of points.
+	for i := 4; i < 10; i++ {
+		require.NoError(t, w.sample(now.Add(time.Duration(i)*time.Second)))
+	}
+	points = w.points()
+	require.Len(t, points, 3)
+	require.Equal(t, now.Add(9*time.Second).Unix(), points[2].Time)
+}
+
+func TestMetricsHandler(t *testing.T) {
+	n := nodeWithMemoryEngine()
+	executor := api.NewExecutor(n, rule.NewContainer(rule.DefaultConfig), "admin")
+	h := NewHandler(n, executor, Config{
+		Password:        "password",
+		Secret:          "secret",
+		MetricsInterval: time.Second,
+		MetricsWindow:   10,
+	})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/metrics", nil))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	token, _ := login(t, h, "", "password")
+	req := httptest.NewRequest(http.MethodGet, "/admin/metrics", nil)
+	req.Header.Set("Authorization", "token "+token)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"interval": 1, "points": []}`, rec.Body.String())
+}
+
+func TestConfigHandler(t *testing.T) {
+	n := nodeWithMemoryEngine()
+	executor := api.NewExecutor(n, rule.NewContainer(rule.DefaultConfig), "admin")
+	h := NewHandler(n, executor, Config{
+		Password: "password",
+		Secret:   "secret",
+		Users: []User{
+			{Username: "bob", Password: "bob_password", Role: RoleReadOnly},
+		},
+		ConfigDump: func() ([]byte, error) {
+			return []byte(`{"options": {}}`), nil
+		},
+	})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/config", nil))
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	token, _ := login(t, h, "bob", "bob_password")
+	req := httptest.NewRequest(http.MethodGet, "/admin/config", nil)
+	req.Header.Set("Authorization", "token "+token)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	token, _ = login(t, h, "", "password")
+	req = httptest.NewRequest(http.MethodGet, "/admin/config", nil)
+	req.Header.Set("Authorization", "token "+token)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"options": {}}`, rec.Body.String())
+}
@@ -0,0 +1,176 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/rs/zerolog/log"
+)
+
+// MetricsPoint contains node metrics rates calculated between two consecutive
+// samples of internal counters.
+type MetricsPoint struct {
+	// Time is a Unix timestamp of point.
+	Time int64 `json:"time"`
+	// Connections is a number of client connections on node.
+	Connections int `json:"connections"`
+	// PublishesPerSec is a rate of publish and broadcast commands coming from
+	// server API and clients.
+	PublishesPerSec float64 `json:"publishes_per_sec"`
+	// MessagesPerSec is a rate of messages sent to clients.
+	MessagesPerSec float64 `json:"messages_per_sec"`
+	// ErrorsPerSec is a rate of error replies sent to clients.
+	ErrorsPerSec float64 `json:"errors_per_sec"`
+}
+
+type metricsSample struct {
+	time        time.Time
+	connections int
+	publishes   float64
+	messages    float64
+	errors      float64
+}
+
+// metricsWindow keeps node-local samples of internal counters over a sliding
+// window to provide cheap time-series data for dashboards.
+type metricsWindow struct {
+	mu         sync.RWMutex
+	gatherer   prometheus.Gatherer
+	numClients func() int
+	size       int
+	samples    []metricsSample
+}
+
+func newMetricsWindow(gatherer prometheus.Gatherer, numClients func() int, size int) *metricsWindow {
+	return &metricsWindow{
+		gatherer:   gatherer,
+		numClients: numClients,
+		size:       size,
+	}
+}
+
+// run samples counters every interval until closeCh closed.
+func (w *metricsWindow) run(interval time.Duration, closeCh <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closeCh:
+			return
+		case now := <-ticker.C:
+			if err := w.sample(now); err != nil {
+				log.Error().Err(err).Msg("error sampling admin metrics")
+			}
+		}
+	}
+}
+
+func metricValue(m *dto.Metric) float64 {
+	switch {
+	case m.Counter != nil:
+		return m.Counter.GetValue()
+	case m.Gauge != nil:
+		return m.Gauge.GetValue()
+	case m.Summary != nil:
+		return float64(m.Summary.GetSampleCount())
+	case m.Histogram != nil:
+		return float64(m.Histogram.GetSampleCount())
+	default:
+		return 0
+	}
+}
+
+func labelValue(m *dto.Metric, name string) string {
+	for _, l := range m.Label {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
+
+// sample gathers internal counters. Metric names matched without namespace
+// prefix since namespace is configurable.
+func (w *metricsWindow) sample(now time.Time) error {
+	families, err := w.gatherer.Gather()
+	if err != nil {
+		return err
+	}
+	s := metricsSample{time: now, connections: w.numClients()}
+	for _, f := range families {
+		name := f.GetName()
+		for _, m := range f.Metric {
+			switch {
+			case strings.HasSuffix(name, "_api_command_duration_seconds"):
+				method := labelValue(m, "method")
+				if method == "publish" || method == "broadcast" {
+					s.publishes += metricValue(m)
+				}
+			case strings.HasSuffix(name, "_client_command_duration_seconds"):
+				if labelValue(m, "method") == "publish" {
+					s.publishes += metricValue(m)
+				}
+			case strings.HasSuffix(name, "_node_messages_sent_count"):
+				s.messages += metricValue(m)
+			case strings.HasSuffix(name, "_client_num_reply_errors"):
+				s.errors += metricValue(m)
+			}
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.samples = append(w.samples, s)
+	// Keep one extra sample to calculate rate for the oldest point in window.
+	if len(w.samples) > w.size+1 {
+		w.samples = w.samples[len(w.samples)-w.size-1:]
+	}
+	return nil
+}
+
+func rate(current, previous float64, seconds float64) float64 {
+	if seconds <= 0 || current < previous {
+		// Counter reset.
+		return 0
+	}
+	return (current - previous) / seconds
+}
+
+// points returns metrics points for current window from oldest to newest.
+func (w *metricsWindow) points() []MetricsPoint {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if len(w.samples) < 2 {
+		return []MetricsPoint{}
+	}
+	points := make([]MetricsPoint, 0, len(w.samples)-1)
+	for i := 1; i < len(w.samples); i++ {
+		prev, cur := w.samples[i-1], w.samples[i]
+		seconds := cur.time.Sub(prev.time).Seconds()
+		points = append(points, MetricsPoint{
+			Time:            cur.time.Unix(),
+			Connections:     cur.connections,
+			PublishesPerSec: rate(cur.publishes, prev.publishes, seconds),
+			MessagesPerSec:  rate(cur.messages, prev.messages, seconds),
+			ErrorsPerSec:    rate(cur.errors, prev.errors, seconds),
+		})
+	}
+	return points
+}
+
+// metricsHandler returns node-local windowed metrics for admin dashboards.
+func (s *Handler) metricsHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	resp := struct {
+		Interval float64        `json:"interval"`
+		Points   []MetricsPoint `json:"points"`
+	}{
+		Interval: s.config.MetricsInterval.Seconds(),
+		Points:   s.metrics.points(),
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
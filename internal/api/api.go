@@ -2,9 +2,18 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
+	"github.com/centrifugal/centrifugo/internal/enginestats"
+	"github.com/centrifugal/centrifugo/internal/maintenance"
+	"github.com/centrifugal/centrifugo/internal/presence"
 	"github.com/centrifugal/centrifugo/internal/rule"
 
 	"github.com/centrifugal/centrifuge"
@@ -19,6 +28,74 @@ type Executor struct {
 	ruleContainer *rule.Container
 	protocol      string
 	rpcExtension  map[string]RPCHandler
+	codecs        map[string]Codec
+
+	connInfoDecoder InfoDecoder
+	chanInfoDecoder InfoDecoder
+
+	publicationTimes PublicationTimes
+	engineStats      *enginestats.Stats
+	maintenance      *maintenance.Mode
+
+	config ExecutorConfig
+}
+
+// ExecutorConfig contains node-wide options of server API commands.
+type ExecutorConfig struct {
+	// BroadcastWorkers limits number of channels broadcast API command
+	// publishes into concurrently. Publications into the same channel are
+	// still delivered in order. Zero value means GOMAXPROCS workers.
+	BroadcastWorkers int
+	// PublishDataValidation sets validation for data published over server API.
+	// By default data passed through as is.
+	PublishDataValidation DataValidation
+	// OverloadConnectionCapacity is a number of connections node considered
+	// fully loaded with. Used to calculate reconnect advice sent to clients
+	// disconnected due to overload. Zero value means node is always considered
+	// fully loaded.
+	OverloadConnectionCapacity int
+	// OverloadReconnectDelayMin is a minimal reconnect delay advised to clients
+	// disconnected due to overload.
+	OverloadReconnectDelayMin time.Duration
+	// OverloadReconnectDelayMax is a maximum reconnect delay advised to clients
+	// disconnected due to overload under full load.
+	OverloadReconnectDelayMax time.Duration
+}
+
+// DataValidation describes how data published into channels must be validated.
+type DataValidation string
+
+const (
+	// DataValidationNone means no validation – data passed through as is.
+	// This is suitable for binary payloads.
+	DataValidationNone DataValidation = "none"
+	// DataValidationJSON requires data to be a well-formed JSON.
+	DataValidationJSON DataValidation = "json"
+	// DataValidationUTF8 requires data to be a valid UTF-8 text.
+	DataValidationUTF8 DataValidation = "utf8"
+)
+
+// Validate ...
+func (c ExecutorConfig) Validate() error {
+	if c.BroadcastWorkers < 0 {
+		return errors.New("broadcast workers can not be negative")
+	}
+	switch c.PublishDataValidation {
+	case "", DataValidationNone, DataValidationJSON, DataValidationUTF8:
+	default:
+		return fmt.Errorf("unknown publish data validation: %s", c.PublishDataValidation)
+	}
+	// Zero max delay turns off reconnect advice so min delay is not used.
+	if c.OverloadReconnectDelayMax > 0 && c.OverloadReconnectDelayMin > c.OverloadReconnectDelayMax {
+		return errors.New("overload reconnect delay min can not be greater than max")
+	}
+	return nil
+}
+
+// PublicationTimes queries channel history by publish time stored in engine.
+// Since returns false when publish times of history publications unknown.
+type PublicationTimes interface {
+	Since(ch string, since time.Time) ([]*centrifuge.Publication, bool, error)
 }
 
 // NewExecutor ...
@@ -28,6 +105,9 @@ func NewExecutor(n *centrifuge.Node, ruleContainer *rule.Container, protocol str
 		ruleContainer: ruleContainer,
 		protocol:      protocol,
 		rpcExtension:  make(map[string]RPCHandler),
+		codecs: map[string]Codec{
+			ContentTypeJSON: validationCodec(DataValidationJSON),
+		},
 	}
 }
 
@@ -36,6 +116,41 @@ func (h Executor) SetRPCExtension(method string, handler RPCHandler) {
 	h.rpcExtension[method] = handler
 }
 
+// SetConfig sets ExecutorConfig. Must be called before Executor used.
+func (h *Executor) SetConfig(c ExecutorConfig) {
+	h.config = c
+}
+
+// SetPublicationTimes sets PublicationTimes used to handle history requests
+// with since field set. Without it such requests not available.
+func (h *Executor) SetPublicationTimes(t PublicationTimes) {
+	h.publicationTimes = t
+}
+
+// SetEngineStats sets engine operation stats reported by engine_health
+// command. Without it engine_health command not available.
+func (h *Executor) SetEngineStats(s *enginestats.Stats) {
+	h.engineStats = s
+}
+
+// SetMaintenance sets maintenance mode toggled by maintenance command.
+// Without it maintenance command not available.
+func (h *Executor) SetMaintenance(m *maintenance.Mode) {
+	h.maintenance = m
+}
+
+// validData checks data according to configured publish data validation.
+func validData(validation DataValidation, data []byte) bool {
+	switch validation {
+	case DataValidationJSON:
+		return json.Valid(data)
+	case DataValidationUTF8:
+		return utf8.Valid(data)
+	default:
+		return true
+	}
+}
+
 // Publish publishes data into channel.
 func (h *Executor) Publish(_ context.Context, cmd *PublishRequest) *PublishResponse {
 	defer observe(time.Now(), h.protocol, "publish")
@@ -57,6 +172,20 @@ func (h *Executor) Publish(_ context.Context, cmd *PublishRequest) *PublishRespo
 		return resp
 	}
 
+	validation := h.config.PublishDataValidation
+	if !validData(validation, data) {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "invalid data for publish", map[string]interface{}{"channel": ch, "validation": string(validation)}))
+		resp.Error = ErrorBadRequest
+		return resp
+	}
+
+	data, err := h.encodeData(cmd.ContentType, data)
+	if err != nil {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error encoding data for publish", map[string]interface{}{"channel": ch, "content_type": cmd.ContentType, "error": err.Error()}))
+		resp.Error = ErrorBadRequest
+		return resp
+	}
+
 	chOpts, found, err := h.ruleContainer.ChannelOptions(ch)
 	if err != nil {
 		resp.Error = ErrorInternal
@@ -68,7 +197,7 @@ func (h *Executor) Publish(_ context.Context, cmd *PublishRequest) *PublishRespo
 	}
 
 	_, err = h.node.Publish(
-		cmd.Channel, cmd.Data,
+		cmd.Channel, data,
 		centrifuge.WithHistory(chOpts.HistorySize, time.Duration(chOpts.HistoryLifetime)*time.Second),
 	)
 	if err != nil {
@@ -100,8 +229,28 @@ func (h *Executor) Broadcast(_ context.Context, cmd *BroadcastRequest) *Broadcas
 		return resp
 	}
 
+	validation := h.config.PublishDataValidation
+	if !validData(validation, data) {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "invalid data for broadcast", map[string]interface{}{"validation": string(validation)}))
+		resp.Error = ErrorBadRequest
+		return resp
+	}
+
+	data, err := h.encodeData(cmd.ContentType, data)
+	if err != nil {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error encoding data for broadcast", map[string]interface{}{"content_type": cmd.ContentType, "error": err.Error()}))
+		resp.Error = ErrorBadRequest
+		return resp
+	}
+
 	errs := make([]error, len(channels))
 
+	workers := h.config.BroadcastWorkers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	sem := make(chan struct{}, workers)
+
 	var wg sync.WaitGroup
 
 	for i, ch := range channels {
@@ -125,7 +274,9 @@ func (h *Executor) Broadcast(_ context.Context, cmd *BroadcastRequest) *Broadcas
 		}
 
 		wg.Add(1)
+		sem <- struct{}{}
 		go func(i int, ch string) {
+			defer func() { <-sem }()
 			_, err := h.node.Publish(
 				ch, data,
 				centrifuge.WithHistory(chOpts.HistorySize, time.Duration(chOpts.HistoryLifetime)*time.Second),
@@ -205,7 +356,20 @@ func (h *Executor) Disconnect(_ context.Context, cmd *DisconnectRequest) *Discon
 		return resp
 	}
 
-	err := h.node.Disconnect(user)
+	disconnect, ok := DisconnectForReason(cmd.Reason)
+	if !ok {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "unknown disconnect reason", map[string]interface{}{"reason": cmd.Reason}))
+		resp.Error = ErrorBadRequest
+		return resp
+	}
+	if disconnect == DisconnectOverload {
+		disconnect = overloadDisconnect(
+			h.node.Hub().NumClients(), h.config.OverloadConnectionCapacity,
+			h.config.OverloadReconnectDelayMin, h.config.OverloadReconnectDelayMax,
+		)
+	}
+
+	err := h.node.Disconnect(user, centrifuge.WithDisconnect(disconnect))
 	if err != nil {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error disconnecting user", map[string]interface{}{"user": cmd.User, "error": err.Error()}))
 		resp.Error = ErrorInternal
@@ -242,21 +406,36 @@ func (h *Executor) Presence(_ context.Context, cmd *PresenceRequest) *PresenceRe
 		return resp
 	}
 
-	presence, err := h.node.Presence(ch)
+	result, err := h.node.Presence(ch)
 	if err != nil {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error calling presence", map[string]interface{}{"error": err.Error()}))
 		resp.Error = ErrorInternal
 		return resp
 	}
 
-	apiPresence := make(map[string]*ClientInfo, len(presence.Presence))
-	for k, v := range presence.Presence {
-		apiPresence[k] = &ClientInfo{
+	var nodePresence map[string]*centrifuge.ClientInfo
+	if h.ruleContainer.Config().PresenceNodeName {
+		nodeResult, err := h.node.Presence(presence.NodeChannel(ch))
+		if err != nil {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error calling node presence", map[string]interface{}{"error": err.Error()}))
+			resp.Error = ErrorInternal
+			return resp
+		}
+		nodePresence = nodeResult.Presence
+	}
+
+	apiPresence := make(map[string]*ClientInfo, len(result.Presence))
+	for k, v := range result.Presence {
+		info := &ClientInfo{
 			User:     v.UserID,
 			Client:   v.ClientID,
 			ConnInfo: v.ConnInfo,
 			ChanInfo: v.ChanInfo,
 		}
+		if nodeInfo, ok := nodePresence[k]; ok {
+			info.Node = string(nodeInfo.ConnInfo)
+		}
+		apiPresence[k] = info
 	}
 
 	resp.Result = &PresenceResult{
@@ -308,6 +487,70 @@ func (h *Executor) PresenceStats(_ context.Context, cmd *PresenceStatsRequest) *
 	return resp
 }
 
+// AggregatedPresenceStats returns presence stats aggregated over all active
+// channels in a subtree defined by channel prefix. Channel hierarchy levels are
+// separated by configured hierarchy delimiter. Presence stats requested only
+// for channels of subtree. User subscribed to several channels of subtree
+// counted in each of them.
+func (h *Executor) AggregatedPresenceStats(_ context.Context, cmd *AggregatedPresenceStatsRequest) *AggregatedPresenceStatsResponse {
+	defer observe(time.Now(), h.protocol, "aggregated_presence_stats")
+
+	resp := &AggregatedPresenceStatsResponse{}
+
+	prefix := cmd.Prefix
+
+	if prefix == "" {
+		resp.Error = ErrorBadRequest
+		return resp
+	}
+
+	channels, err := h.node.Channels()
+	if err != nil {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error calling channels", map[string]interface{}{"error": err.Error()}))
+		resp.Error = ErrorInternal
+		return resp
+	}
+
+	delimiter := h.ruleContainer.Config().ChannelHierarchyDelimiter
+
+	result := &AggregatedPresenceStatsResult{}
+
+	for _, ch := range channels {
+		if !inChannelSubtree(ch, prefix, delimiter) {
+			continue
+		}
+		chOpts, found, err := h.ruleContainer.ChannelOptions(ch)
+		if err != nil {
+			resp.Error = ErrorInternal
+			return resp
+		}
+		if !found || !chOpts.Presence {
+			continue
+		}
+		stats, err := h.node.PresenceStats(ch)
+		if err != nil {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error calling presence stats", map[string]interface{}{"error": err.Error(), "channel": ch}))
+			resp.Error = ErrorInternal
+			return resp
+		}
+		result.NumChannels++
+		result.NumClients += uint32(stats.NumClients)
+		result.NumUsers += uint32(stats.NumUsers)
+	}
+
+	resp.Result = result
+	return resp
+}
+
+// inChannelSubtree checks whether channel is a prefix channel itself or belongs
+// to its subtree. When delimiter is empty simple prefix match used.
+func inChannelSubtree(ch string, prefix string, delimiter string) bool {
+	if delimiter == "" || strings.HasSuffix(prefix, delimiter) {
+		return strings.HasPrefix(ch, prefix)
+	}
+	return ch == prefix || strings.HasPrefix(ch, prefix+delimiter)
+}
+
 // History returns response with history information for channel.
 func (h *Executor) History(_ context.Context, cmd *HistoryRequest) *HistoryResponse {
 	defer observe(time.Now(), h.protocol, "history")
@@ -336,16 +579,42 @@ func (h *Executor) History(_ context.Context, cmd *HistoryRequest) *HistoryRespo
 		return resp
 	}
 
-	history, err := h.node.History(ch, centrifuge.WithLimit(centrifuge.NoLimit))
-	if err != nil {
-		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error calling history", map[string]interface{}{"error": err.Error()}))
-		resp.Error = ErrorInternal
+	if cmd.Since < 0 {
+		resp.Error = ErrorBadRequest
 		return resp
 	}
+	if cmd.Since > 0 && h.publicationTimes == nil {
+		resp.Error = ErrorNotAvailable
+		return resp
+	}
+
+	var publications []*centrifuge.Publication
+	if cmd.Since > 0 {
+		pubs, ok, err := h.publicationTimes.Since(ch, time.Unix(0, cmd.Since*int64(time.Millisecond)))
+		if err != nil {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error calling history", map[string]interface{}{"error": err.Error()}))
+			resp.Error = ErrorInternal
+			return resp
+		}
+		if !ok {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "publication times unknown for history since", map[string]interface{}{"channel": ch}))
+			resp.Error = ErrorNotAvailable
+			return resp
+		}
+		publications = pubs
+	} else {
+		history, err := h.node.History(ch, centrifuge.WithLimit(centrifuge.NoLimit))
+		if err != nil {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error calling history", map[string]interface{}{"error": err.Error()}))
+			resp.Error = ErrorInternal
+			return resp
+		}
+		publications = history.Publications
+	}
 
-	apiPubs := make([]*Publication, len(history.Publications))
+	apiPubs := make([]*Publication, len(publications))
 
-	for i, pub := range history.Publications {
+	for i, pub := range publications {
 		apiPub := &Publication{
 			Data: Raw(pub.Data),
 		}
@@ -461,6 +730,59 @@ func (h *Executor) Info(_ context.Context, _ *InfoRequest) *InfoResponse {
 	return resp
 }
 
+// EngineHealth returns latency and error rate of recent engine operations
+// performed by current node.
+func (h *Executor) EngineHealth(_ context.Context, _ *EngineHealthRequest) *EngineHealthResponse {
+	defer observe(time.Now(), h.protocol, "engine_health")
+
+	resp := &EngineHealthResponse{}
+
+	if h.engineStats == nil {
+		resp.Error = ErrorNotAvailable
+		return resp
+	}
+
+	summary := h.engineStats.Summary()
+	operations := make([]*EngineOperationHealth, len(summary))
+	for i, item := range summary {
+		operations[i] = &EngineOperationHealth{
+			Operation: item.Operation,
+			Count:     uint32(item.Count),
+			P99Ms:     float64(item.P99) / float64(time.Millisecond),
+			ErrorRate: item.ErrorRate,
+		}
+	}
+	resp.Result = &EngineHealthResult{
+		Operations: operations,
+	}
+	return resp
+}
+
+// Maintenance enables or disables maintenance mode of current node.
+func (h *Executor) Maintenance(_ context.Context, cmd *MaintenanceRequest) *MaintenanceResponse {
+	defer observe(time.Now(), h.protocol, "maintenance")
+
+	resp := &MaintenanceResponse{}
+
+	if h.maintenance == nil {
+		resp.Error = ErrorNotAvailable
+		return resp
+	}
+
+	h.maintenance.Set(maintenance.State{
+		Enabled:        cmd.Enabled,
+		Message:        cmd.Message,
+		BlockSubscribe: cmd.BlockSubscribe,
+	})
+	state := h.maintenance.State()
+	resp.Result = &MaintenanceResult{
+		Enabled:        state.Enabled,
+		Message:        state.Message,
+		BlockSubscribe: state.BlockSubscribe,
+	}
+	return resp
+}
+
 // RPC ...
 func (h *Executor) RPC(ctx context.Context, cmd *RPCRequest) *RPCResponse {
 	defer observe(time.Now(), h.protocol, "history_remove")
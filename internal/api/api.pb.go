@@ -31,17 +31,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MethodType int32
 
 const (
-	MethodTypePublish       MethodType = 0
-	MethodTypeBroadcast     MethodType = 1
-	MethodTypeUnsubscribe   MethodType = 2
-	MethodTypeDisconnect    MethodType = 3
-	MethodTypePresence      MethodType = 4
-	MethodTypePresenceStats MethodType = 5
-	MethodTypeHistory       MethodType = 6
-	MethodTypeHistoryRemove MethodType = 7
-	MethodTypeChannels      MethodType = 8
-	MethodTypeInfo          MethodType = 9
-	MethodTypeRPC           MethodType = 10
+	MethodTypePublish                 MethodType = 0
+	MethodTypeBroadcast               MethodType = 1
+	MethodTypeUnsubscribe             MethodType = 2
+	MethodTypeDisconnect              MethodType = 3
+	MethodTypePresence                MethodType = 4
+	MethodTypePresenceStats           MethodType = 5
+	MethodTypeHistory                 MethodType = 6
+	MethodTypeHistoryRemove           MethodType = 7
+	MethodTypeChannels                MethodType = 8
+	MethodTypeInfo                    MethodType = 9
+	MethodTypeRPC                     MethodType = 10
+	MethodTypeAggregatedPresenceStats MethodType = 11
+	MethodTypeEngineHealth            MethodType = 12
+	MethodTypeMaintenance             MethodType = 13
 )
 
 var MethodType_name = map[int32]string{
@@ -56,20 +59,26 @@ var MethodType_name = map[int32]string{
 	8:  "CHANNELS",
 	9:  "INFO",
 	10: "RPC",
+	11: "AGGREGATED_PRESENCE_STATS",
+	12: "ENGINE_HEALTH",
+	13: "MAINTENANCE",
 }
 
 var MethodType_value = map[string]int32{
-	"PUBLISH":        0,
-	"BROADCAST":      1,
-	"UNSUBSCRIBE":    2,
-	"DISCONNECT":     3,
-	"PRESENCE":       4,
-	"PRESENCE_STATS": 5,
-	"HISTORY":        6,
-	"HISTORY_REMOVE": 7,
-	"CHANNELS":       8,
-	"INFO":           9,
-	"RPC":            10,
+	"PUBLISH":                   0,
+	"BROADCAST":                 1,
+	"UNSUBSCRIBE":               2,
+	"DISCONNECT":                3,
+	"PRESENCE":                  4,
+	"PRESENCE_STATS":            5,
+	"HISTORY":                   6,
+	"HISTORY_REMOVE":            7,
+	"CHANNELS":                  8,
+	"INFO":                      9,
+	"RPC":                       10,
+	"AGGREGATED_PRESENCE_STATS": 11,
+	"ENGINE_HEALTH":             12,
+	"MAINTENANCE":               13,
 }
 
 func (x MethodType) String() string {
@@ -85,6 +94,7 @@ type ClientInfo struct {
 	Client   string `protobuf:"bytes,2,opt,name=client,proto3" json:"client"`
 	ConnInfo Raw    `protobuf:"bytes,3,opt,name=conn_info,json=connInfo,proto3,customtype=Raw" json:"conn_info,omitempty"`
 	ChanInfo Raw    `protobuf:"bytes,4,opt,name=chan_info,json=chanInfo,proto3,customtype=Raw" json:"chan_info,omitempty"`
+	Node     string `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
 }
 
 func (m *ClientInfo) Reset()         { *m = ClientInfo{} }
@@ -134,6 +144,13 @@ func (m *ClientInfo) GetClient() string {
 	return ""
 }
 
+func (m *ClientInfo) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
 type Publication struct {
 	UID  string      `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 	Data Raw         `protobuf:"bytes,2,opt,name=data,proto3,customtype=Raw" json:"data"`
@@ -346,8 +363,10 @@ func (m *Reply) GetError() *Error {
 }
 
 type PublishRequest struct {
-	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
-	Data    Raw    `protobuf:"bytes,2,opt,name=data,proto3,customtype=Raw" json:"data"`
+	Channel     string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
+	Data        Raw    `protobuf:"bytes,2,opt,name=data,proto3,customtype=Raw" json:"data"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	OrderingKey string `protobuf:"bytes,4,opt,name=ordering_key,json=orderingKey,proto3" json:"ordering_key,omitempty"`
 }
 
 func (m *PublishRequest) Reset()         { *m = PublishRequest{} }
@@ -390,6 +409,20 @@ func (m *PublishRequest) GetChannel() string {
 	return ""
 }
 
+func (m *PublishRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *PublishRequest) GetOrderingKey() string {
+	if m != nil {
+		return m.OrderingKey
+	}
+	return ""
+}
+
 type PublishResponse struct {
 	Error  *Error         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	Result *PublishResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
@@ -479,8 +512,9 @@ func (m *PublishResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_PublishResult proto.InternalMessageInfo
 
 type BroadcastRequest struct {
-	Channels []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
-	Data     Raw      `protobuf:"bytes,2,opt,name=data,proto3,customtype=Raw" json:"data"`
+	Channels    []string `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+	Data        Raw      `protobuf:"bytes,2,opt,name=data,proto3,customtype=Raw" json:"data"`
+	ContentType string   `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 }
 
 func (m *BroadcastRequest) Reset()         { *m = BroadcastRequest{} }
@@ -523,6 +557,13 @@ func (m *BroadcastRequest) GetChannels() []string {
 	return nil
 }
 
+func (m *BroadcastRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
 type BroadcastResponse struct {
 	Error  *Error           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	Result *BroadcastResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
@@ -752,7 +793,8 @@ func (m *UnsubscribeResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_UnsubscribeResult proto.InternalMessageInfo
 
 type DisconnectRequest struct {
-	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	User   string `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *DisconnectRequest) Reset()         { *m = DisconnectRequest{} }
@@ -795,6 +837,13 @@ func (m *DisconnectRequest) GetUser() string {
 	return ""
 }
 
+func (m *DisconnectRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type DisconnectResponse struct {
 	Error  *Error            `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	Result *DisconnectResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
@@ -1171,15 +1220,172 @@ func (m *PresenceStatsResult) GetNumUsers() uint32 {
 	return 0
 }
 
+type AggregatedPresenceStatsRequest struct {
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix"`
+}
+
+func (m *AggregatedPresenceStatsRequest) Reset()         { *m = AggregatedPresenceStatsRequest{} }
+func (m *AggregatedPresenceStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregatedPresenceStatsRequest) ProtoMessage()    {}
+func (*AggregatedPresenceStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+}
+func (m *AggregatedPresenceStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedPresenceStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedPresenceStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedPresenceStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedPresenceStatsRequest.Merge(m, src)
+}
+func (m *AggregatedPresenceStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedPresenceStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedPresenceStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedPresenceStatsRequest proto.InternalMessageInfo
+
+func (m *AggregatedPresenceStatsRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+type AggregatedPresenceStatsResponse struct {
+	Error  *Error                         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Result *AggregatedPresenceStatsResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *AggregatedPresenceStatsResponse) Reset()         { *m = AggregatedPresenceStatsResponse{} }
+func (m *AggregatedPresenceStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregatedPresenceStatsResponse) ProtoMessage()    {}
+func (*AggregatedPresenceStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+}
+func (m *AggregatedPresenceStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedPresenceStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedPresenceStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedPresenceStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedPresenceStatsResponse.Merge(m, src)
+}
+func (m *AggregatedPresenceStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedPresenceStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedPresenceStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedPresenceStatsResponse proto.InternalMessageInfo
+
+func (m *AggregatedPresenceStatsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *AggregatedPresenceStatsResponse) GetResult() *AggregatedPresenceStatsResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type AggregatedPresenceStatsResult struct {
+	NumChannels uint32 `protobuf:"varint,1,opt,name=num_channels,json=numChannels,proto3" json:"num_channels"`
+	NumClients  uint32 `protobuf:"varint,2,opt,name=num_clients,json=numClients,proto3" json:"num_clients"`
+	NumUsers    uint32 `protobuf:"varint,3,opt,name=num_users,json=numUsers,proto3" json:"num_users"`
+}
+
+func (m *AggregatedPresenceStatsResult) Reset()         { *m = AggregatedPresenceStatsResult{} }
+func (m *AggregatedPresenceStatsResult) String() string { return proto.CompactTextString(m) }
+func (*AggregatedPresenceStatsResult) ProtoMessage()    {}
+func (*AggregatedPresenceStatsResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+}
+func (m *AggregatedPresenceStatsResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregatedPresenceStatsResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregatedPresenceStatsResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregatedPresenceStatsResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedPresenceStatsResult.Merge(m, src)
+}
+func (m *AggregatedPresenceStatsResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregatedPresenceStatsResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedPresenceStatsResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedPresenceStatsResult proto.InternalMessageInfo
+
+func (m *AggregatedPresenceStatsResult) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *AggregatedPresenceStatsResult) GetNumClients() uint32 {
+	if m != nil {
+		return m.NumClients
+	}
+	return 0
+}
+
+func (m *AggregatedPresenceStatsResult) GetNumUsers() uint32 {
+	if m != nil {
+		return m.NumUsers
+	}
+	return 0
+}
+
 type HistoryRequest struct {
 	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
+	Since   int64  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
 }
 
 func (m *HistoryRequest) Reset()         { *m = HistoryRequest{} }
 func (m *HistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*HistoryRequest) ProtoMessage()    {}
 func (*HistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 func (m *HistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,6 +1421,13 @@ func (m *HistoryRequest) GetChannel() string {
 	return ""
 }
 
+func (m *HistoryRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
 type HistoryResponse struct {
 	Error  *Error         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	Result *HistoryResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
@@ -1224,7 +1437,7 @@ func (m *HistoryResponse) Reset()         { *m = HistoryResponse{} }
 func (m *HistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*HistoryResponse) ProtoMessage()    {}
 func (*HistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
 }
 func (m *HistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1275,7 +1488,7 @@ func (m *HistoryResult) Reset()         { *m = HistoryResult{} }
 func (m *HistoryResult) String() string { return proto.CompactTextString(m) }
 func (*HistoryResult) ProtoMessage()    {}
 func (*HistoryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 func (m *HistoryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1319,7 +1532,7 @@ func (m *HistoryRemoveRequest) Reset()         { *m = HistoryRemoveRequest{} }
 func (m *HistoryRemoveRequest) String() string { return proto.CompactTextString(m) }
 func (*HistoryRemoveRequest) ProtoMessage()    {}
 func (*HistoryRemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 func (m *HistoryRemoveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1364,7 +1577,7 @@ func (m *HistoryRemoveResponse) Reset()         { *m = HistoryRemoveResponse{} }
 func (m *HistoryRemoveResponse) String() string { return proto.CompactTextString(m) }
 func (*HistoryRemoveResponse) ProtoMessage()    {}
 func (*HistoryRemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 func (m *HistoryRemoveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1414,7 +1627,7 @@ func (m *HistoryRemoveResult) Reset()         { *m = HistoryRemoveResult{} }
 func (m *HistoryRemoveResult) String() string { return proto.CompactTextString(m) }
 func (*HistoryRemoveResult) ProtoMessage()    {}
 func (*HistoryRemoveResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
 }
 func (m *HistoryRemoveResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1450,7 +1663,7 @@ func (m *ChannelsRequest) Reset()         { *m = ChannelsRequest{} }
 func (m *ChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ChannelsRequest) ProtoMessage()    {}
 func (*ChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 func (m *ChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1488,7 +1701,7 @@ func (m *ChannelsResponse) Reset()         { *m = ChannelsResponse{} }
 func (m *ChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ChannelsResponse) ProtoMessage()    {}
 func (*ChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
 func (m *ChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1539,7 +1752,7 @@ func (m *ChannelsResult) Reset()         { *m = ChannelsResult{} }
 func (m *ChannelsResult) String() string { return proto.CompactTextString(m) }
 func (*ChannelsResult) ProtoMessage()    {}
 func (*ChannelsResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 func (m *ChannelsResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1582,7 +1795,7 @@ func (m *InfoRequest) Reset()         { *m = InfoRequest{} }
 func (m *InfoRequest) String() string { return proto.CompactTextString(m) }
 func (*InfoRequest) ProtoMessage()    {}
 func (*InfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 func (m *InfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1620,7 +1833,7 @@ func (m *InfoResponse) Reset()         { *m = InfoResponse{} }
 func (m *InfoResponse) String() string { return proto.CompactTextString(m) }
 func (*InfoResponse) ProtoMessage()    {}
 func (*InfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
 }
 func (m *InfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1671,7 +1884,7 @@ func (m *InfoResult) Reset()         { *m = InfoResult{} }
 func (m *InfoResult) String() string { return proto.CompactTextString(m) }
 func (*InfoResult) ProtoMessage()    {}
 func (*InfoResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
 func (m *InfoResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1716,7 +1929,7 @@ func (m *RPCRequest) Reset()         { *m = RPCRequest{} }
 func (m *RPCRequest) String() string { return proto.CompactTextString(m) }
 func (*RPCRequest) ProtoMessage()    {}
 func (*RPCRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
 func (m *RPCRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1761,7 +1974,7 @@ func (m *RPCResponse) Reset()         { *m = RPCResponse{} }
 func (m *RPCResponse) String() string { return proto.CompactTextString(m) }
 func (*RPCResponse) ProtoMessage()    {}
 func (*RPCResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
 func (m *RPCResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1812,7 +2025,7 @@ func (m *RPCResult) Reset()         { *m = RPCResult{} }
 func (m *RPCResult) String() string { return proto.CompactTextString(m) }
 func (*RPCResult) ProtoMessage()    {}
 func (*RPCResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 func (m *RPCResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1856,7 +2069,7 @@ func (m *NodeResult) Reset()         { *m = NodeResult{} }
 func (m *NodeResult) String() string { return proto.CompactTextString(m) }
 func (*NodeResult) ProtoMessage()    {}
 func (*NodeResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
 }
 func (m *NodeResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1941,23 +2154,21 @@ func (m *NodeResult) GetMetrics() *Metrics {
 	return nil
 }
 
-type Metrics struct {
-	Interval float64            `protobuf:"fixed64,1,opt,name=interval,proto3" json:"interval"`
-	Items    map[string]float64 `protobuf:"bytes,2,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+type EngineHealthRequest struct {
 }
 
-func (m *Metrics) Reset()         { *m = Metrics{} }
-func (m *Metrics) String() string { return proto.CompactTextString(m) }
-func (*Metrics) ProtoMessage()    {}
-func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+func (m *EngineHealthRequest) Reset()         { *m = EngineHealthRequest{} }
+func (m *EngineHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*EngineHealthRequest) ProtoMessage()    {}
+func (*EngineHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
 }
-func (m *Metrics) XXX_Unmarshal(b []byte) error {
+func (m *EngineHealthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Metrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EngineHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Metrics.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EngineHealthRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1967,378 +2178,617 @@ func (m *Metrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Metrics) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Metrics.Merge(m, src)
+func (m *EngineHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EngineHealthRequest.Merge(m, src)
 }
-func (m *Metrics) XXX_Size() int {
+func (m *EngineHealthRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Metrics) XXX_DiscardUnknown() {
-	xxx_messageInfo_Metrics.DiscardUnknown(m)
+func (m *EngineHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EngineHealthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Metrics proto.InternalMessageInfo
+var xxx_messageInfo_EngineHealthRequest proto.InternalMessageInfo
 
-func (m *Metrics) GetInterval() float64 {
-	if m != nil {
-		return m.Interval
+type EngineHealthResponse struct {
+	Error  *Error              `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Result *EngineHealthResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *EngineHealthResponse) Reset()         { *m = EngineHealthResponse{} }
+func (m *EngineHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*EngineHealthResponse) ProtoMessage()    {}
+func (*EngineHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+}
+func (m *EngineHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EngineHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EngineHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *EngineHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EngineHealthResponse.Merge(m, src)
+}
+func (m *EngineHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EngineHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EngineHealthResponse.DiscardUnknown(m)
 }
 
-func (m *Metrics) GetItems() map[string]float64 {
+var xxx_messageInfo_EngineHealthResponse proto.InternalMessageInfo
+
+func (m *EngineHealthResponse) GetError() *Error {
 	if m != nil {
-		return m.Items
+		return m.Error
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("api.MethodType", MethodType_name, MethodType_value)
-	proto.RegisterType((*ClientInfo)(nil), "api.ClientInfo")
-	proto.RegisterType((*Publication)(nil), "api.Publication")
-	proto.RegisterType((*Error)(nil), "api.Error")
-	proto.RegisterType((*Command)(nil), "api.Command")
-	proto.RegisterType((*Reply)(nil), "api.Reply")
-	proto.RegisterType((*PublishRequest)(nil), "api.PublishRequest")
-	proto.RegisterType((*PublishResponse)(nil), "api.PublishResponse")
-	proto.RegisterType((*PublishResult)(nil), "api.PublishResult")
-	proto.RegisterType((*BroadcastRequest)(nil), "api.BroadcastRequest")
-	proto.RegisterType((*BroadcastResponse)(nil), "api.BroadcastResponse")
-	proto.RegisterType((*BroadcastResult)(nil), "api.BroadcastResult")
-	proto.RegisterType((*UnsubscribeRequest)(nil), "api.UnsubscribeRequest")
-	proto.RegisterType((*UnsubscribeResponse)(nil), "api.UnsubscribeResponse")
-	proto.RegisterType((*UnsubscribeResult)(nil), "api.UnsubscribeResult")
-	proto.RegisterType((*DisconnectRequest)(nil), "api.DisconnectRequest")
-	proto.RegisterType((*DisconnectResponse)(nil), "api.DisconnectResponse")
-	proto.RegisterType((*DisconnectResult)(nil), "api.DisconnectResult")
-	proto.RegisterType((*PresenceRequest)(nil), "api.PresenceRequest")
-	proto.RegisterType((*PresenceResponse)(nil), "api.PresenceResponse")
-	proto.RegisterType((*PresenceResult)(nil), "api.PresenceResult")
-	proto.RegisterMapType((map[string]*ClientInfo)(nil), "api.PresenceResult.PresenceEntry")
-	proto.RegisterType((*PresenceStatsRequest)(nil), "api.PresenceStatsRequest")
-	proto.RegisterType((*PresenceStatsResponse)(nil), "api.PresenceStatsResponse")
-	proto.RegisterType((*PresenceStatsResult)(nil), "api.PresenceStatsResult")
-	proto.RegisterType((*HistoryRequest)(nil), "api.HistoryRequest")
-	proto.RegisterType((*HistoryResponse)(nil), "api.HistoryResponse")
-	proto.RegisterType((*HistoryResult)(nil), "api.HistoryResult")
-	proto.RegisterType((*HistoryRemoveRequest)(nil), "api.HistoryRemoveRequest")
-	proto.RegisterType((*HistoryRemoveResponse)(nil), "api.HistoryRemoveResponse")
-	proto.RegisterType((*HistoryRemoveResult)(nil), "api.HistoryRemoveResult")
-	proto.RegisterType((*ChannelsRequest)(nil), "api.ChannelsRequest")
-	proto.RegisterType((*ChannelsResponse)(nil), "api.ChannelsResponse")
-	proto.RegisterType((*ChannelsResult)(nil), "api.ChannelsResult")
-	proto.RegisterType((*InfoRequest)(nil), "api.InfoRequest")
-	proto.RegisterType((*InfoResponse)(nil), "api.InfoResponse")
-	proto.RegisterType((*InfoResult)(nil), "api.InfoResult")
-	proto.RegisterType((*RPCRequest)(nil), "api.RPCRequest")
-	proto.RegisterType((*RPCResponse)(nil), "api.RPCResponse")
-	proto.RegisterType((*RPCResult)(nil), "api.RPCResult")
-	proto.RegisterType((*NodeResult)(nil), "api.NodeResult")
-	proto.RegisterType((*Metrics)(nil), "api.Metrics")
-	proto.RegisterMapType((map[string]float64)(nil), "api.Metrics.ItemsEntry")
+func (m *EngineHealthResponse) GetResult() *EngineHealthResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
-
-var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1739 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0xf9, 0x23, 0xb6, 0x9f, 0xbf, 0x3a, 0x65, 0x3b, 0xf1, 0x98, 0x91, 0xdb, 0xb4, 0x76,
-	0x97, 0x28, 0x62, 0x66, 0x96, 0x19, 0xd8, 0x19, 0x56, 0xbb, 0x2c, 0x69, 0xc7, 0xab, 0x18, 0x66,
-	0x9d, 0xa8, 0x9c, 0x20, 0xad, 0x38, 0x84, 0x8e, 0xdd, 0x93, 0xb4, 0x88, 0xbb, 0x4d, 0x7f, 0x0c,
-	0xca, 0x15, 0x71, 0x40, 0x06, 0xa1, 0x11, 0x07, 0x2e, 0xc8, 0xe2, 0x00, 0x12, 0x48, 0xfc, 0x03,
-	0x1c, 0x39, 0xce, 0x71, 0x8e, 0x88, 0x43, 0x03, 0x99, 0x9b, 0xff, 0x02, 0x8e, 0xa8, 0xaa, 0xfa,
-	0x3b, 0x66, 0x3c, 0x21, 0x9a, 0x8b, 0xbb, 0x3e, 0xde, 0x7b, 0xf5, 0xde, 0xaf, 0x5e, 0x55, 0xfd,
-	0x9e, 0xa1, 0xa0, 0x4c, 0xb5, 0xfb, 0x53, 0xd3, 0xb0, 0x0d, 0x9c, 0x56, 0xa6, 0x5a, 0xeb, 0xde,
-	0x99, 0x66, 0x9f, 0x3b, 0xa7, 0xf7, 0x47, 0xc6, 0xe4, 0xc1, 0x99, 0x71, 0x66, 0x3c, 0x60, 0x73,
-	0xa7, 0xce, 0x33, 0xd6, 0x63, 0x1d, 0xd6, 0xe2, 0x3a, 0xd2, 0x2b, 0x04, 0xd0, 0xbd, 0xd0, 0x54,
-	0xdd, 0xee, 0xeb, 0xcf, 0x0c, 0x7c, 0x17, 0x32, 0x8e, 0xa5, 0x9a, 0x4d, 0xd4, 0x41, 0xdb, 0x05,
-	0x39, 0xbf, 0x70, 0x45, 0xd6, 0x27, 0xec, 0x17, 0x4b, 0xb0, 0x3e, 0x62, 0xb2, 0xcd, 0x14, 0x9b,
-	0x87, 0x85, 0x2b, 0x7a, 0x23, 0xc4, 0xfb, 0xe2, 0xcf, 0xa0, 0x30, 0x32, 0x74, 0xfd, 0x44, 0xd3,
-	0x9f, 0x19, 0xcd, 0x74, 0x07, 0x6d, 0x97, 0x64, 0xe9, 0xa5, 0x2b, 0xae, 0xfd, 0xc3, 0x15, 0xd3,
-	0x44, 0xf9, 0xe9, 0xc2, 0x15, 0x6b, 0xc1, 0xfc, 0xd7, 0x8d, 0x89, 0x66, 0xab, 0x93, 0xa9, 0x7d,
-	0x49, 0xf2, 0x74, 0x90, 0xb9, 0x40, 0x0d, 0x9c, 0x2b, 0x9e, 0x81, 0xcc, 0x72, 0x03, 0xfe, 0x7c,
-	0xcc, 0xc0, 0xb9, 0xc2, 0x0c, 0x48, 0xbf, 0x43, 0x50, 0x3c, 0x74, 0x4e, 0x2f, 0xb4, 0x91, 0x62,
-	0x6b, 0x86, 0x8e, 0x77, 0x20, 0xed, 0x68, 0x63, 0x2f, 0xa4, 0xe6, 0x95, 0x2b, 0xa6, 0x8f, 0xfb,
-	0x7b, 0x0b, 0x57, 0x2c, 0x3b, 0xda, 0x38, 0x62, 0x80, 0x0a, 0xe1, 0xaf, 0x41, 0x66, 0xac, 0xd8,
-	0x0a, 0x8b, 0xaf, 0x24, 0xd7, 0xe2, 0xeb, 0xb2, 0x29, 0xc2, 0x7e, 0xf1, 0x63, 0xc8, 0x04, 0x11,
-	0x16, 0x1f, 0x56, 0xef, 0xd3, 0x5d, 0x08, 0x71, 0x94, 0xf1, 0xc2, 0x15, 0x2b, 0x09, 0x0f, 0x99,
-	0x82, 0xf4, 0x14, 0xb2, 0x3d, 0xd3, 0x34, 0x4c, 0x0a, 0xf5, 0xc8, 0x18, 0xab, 0xcc, 0xaf, 0x32,
-	0x87, 0x9a, 0xf6, 0x09, 0xfb, 0xc5, 0xef, 0x43, 0x6e, 0xa2, 0x5a, 0x96, 0x72, 0xa6, 0x7a, 0x58,
-	0x17, 0x17, 0xae, 0xe8, 0x0f, 0x11, 0xbf, 0x21, 0xfd, 0x12, 0x41, 0xae, 0x6b, 0x4c, 0x26, 0x8a,
-	0x3e, 0xc6, 0x77, 0x21, 0xe5, 0x85, 0x59, 0x96, 0x4b, 0x57, 0xae, 0x98, 0x62, 0x51, 0xa6, 0xb4,
-	0x31, 0x49, 0x69, 0x63, 0xfc, 0x08, 0xd6, 0x27, 0xaa, 0x7d, 0x6e, 0x8c, 0x99, 0xbd, 0x8a, 0xe7,
-	0xf2, 0x17, 0x6c, 0xe8, 0xe8, 0x72, 0xaa, 0xf2, 0xcd, 0xe4, 0x22, 0xc4, 0xfb, 0xe2, 0x7b, 0xb0,
-	0x3e, 0x55, 0x4c, 0x65, 0x62, 0x79, 0x3b, 0xd9, 0x88, 0x03, 0xe2, 0x4d, 0x12, 0xef, 0x2b, 0xfd,
-	0x1e, 0x41, 0x96, 0xa8, 0xd3, 0x8b, 0x4b, 0xfc, 0x41, 0xc4, 0x97, 0xcd, 0xc0, 0x97, 0x52, 0x0c,
-	0x70, 0xea, 0xd5, 0xb7, 0x20, 0xab, 0x52, 0x34, 0x98, 0x53, 0xc5, 0x87, 0xc0, 0x9c, 0x62, 0xf8,
-	0xc8, 0xb5, 0x85, 0x2b, 0x56, 0xd9, 0x64, 0x44, 0x87, 0x4b, 0xe3, 0xc7, 0xb0, 0x6e, 0xaa, 0x96,
-	0x73, 0x61, 0x7b, 0x7e, 0x89, 0x71, 0xbf, 0x04, 0x3e, 0x19, 0xd1, 0xf3, 0xc4, 0xa5, 0x1f, 0x41,
-	0x85, 0xa5, 0x86, 0x75, 0x4e, 0xd4, 0x9f, 0x38, 0xaa, 0x65, 0x53, 0xa0, 0x69, 0xe6, 0xe8, 0xea,
-	0x85, 0x97, 0x21, 0x0c, 0x68, 0x6f, 0x88, 0xf8, 0x8d, 0xb7, 0x4e, 0x0c, 0x69, 0x86, 0xa0, 0x1a,
-	0x2c, 0x61, 0x4d, 0x0d, 0xdd, 0x52, 0xc3, 0x28, 0xd1, 0x8d, 0xa2, 0xfc, 0x6e, 0x10, 0x25, 0x47,
-	0x07, 0x33, 0xbd, 0xd0, 0xb8, 0x73, 0x61, 0xcb, 0xf5, 0x37, 0x86, 0x5b, 0x85, 0x72, 0x4c, 0x5c,
-	0x52, 0x41, 0x90, 0x4d, 0x43, 0x19, 0x8f, 0x14, 0xcb, 0xf6, 0x11, 0xd8, 0x86, 0xbc, 0x17, 0xa5,
-	0xd5, 0x44, 0x9d, 0xf4, 0x76, 0x41, 0x2e, 0x2d, 0x5c, 0x31, 0x18, 0x23, 0x41, 0xeb, 0xed, 0x41,
-	0xf8, 0x35, 0x82, 0x8d, 0xc8, 0x3a, 0xb7, 0x83, 0x41, 0x4e, 0xc0, 0x50, 0x67, 0x7a, 0x51, 0xf3,
-	0xab, 0x81, 0xd8, 0x80, 0x6a, 0x42, 0x41, 0xfa, 0x12, 0xf0, 0xb1, 0x6e, 0x39, 0xa7, 0xd6, 0xc8,
-	0xd4, 0x4e, 0xd5, 0x1b, 0xa6, 0x83, 0x7f, 0x4f, 0xa6, 0x96, 0xdd, 0x93, 0xd2, 0x6f, 0x10, 0xd4,
-	0x62, 0xb6, 0x6f, 0x07, 0xc0, 0x5e, 0x02, 0x80, 0x4d, 0xa6, 0x17, 0x5f, 0x60, 0x35, 0x04, 0x35,
-	0xd8, 0xb8, 0xa6, 0x22, 0x7d, 0x03, 0x36, 0xf6, 0x34, 0x8b, 0xde, 0xbd, 0xea, 0x28, 0x48, 0x88,
-	0x37, 0x3e, 0x02, 0xd2, 0x0b, 0x04, 0x38, 0xaa, 0x73, 0xbb, 0xd8, 0xba, 0x89, 0xd8, 0x1a, 0x4c,
-	0x2f, 0x66, 0x7f, 0x75, 0x68, 0x18, 0x84, 0xa4, 0x86, 0xf4, 0x04, 0xaa, 0x87, 0xa6, 0x6a, 0xa9,
-	0xfa, 0xe8, 0x86, 0x7b, 0x2b, 0xfd, 0x0a, 0x81, 0x10, 0xaa, 0xde, 0x2e, 0xbc, 0xdd, 0x44, 0x78,
-	0x35, 0x7e, 0x84, 0x43, 0xeb, 0xab, 0x83, 0xfb, 0x0b, 0x82, 0x4a, 0x5c, 0x01, 0x7f, 0x1f, 0xf2,
-	0x53, 0x6f, 0x84, 0x9d, 0xd8, 0xe2, 0xc3, 0xaf, 0x2e, 0xb1, 0x1b, 0x74, 0x7b, 0xba, 0x6d, 0x5e,
-	0xf2, 0x43, 0xed, 0xab, 0x91, 0xa0, 0xd5, 0x7a, 0x0a, 0xe5, 0x98, 0x20, 0x16, 0x20, 0xfd, 0x63,
-	0xf5, 0x92, 0x43, 0x44, 0x68, 0x13, 0xbf, 0x0f, 0xd9, 0xe7, 0xca, 0x85, 0xa3, 0x7a, 0x41, 0x24,
-	0x5f, 0x3b, 0xc2, 0x67, 0x3f, 0x4e, 0x3d, 0x41, 0xd2, 0xa7, 0x50, 0xf7, 0xad, 0x0d, 0x6d, 0xc5,
-	0xb6, 0x6e, 0x88, 0xfd, 0x6f, 0x11, 0x34, 0x12, 0xfa, 0xb7, 0xdb, 0x80, 0xcf, 0x13, 0x1b, 0xd0,
-	0x8c, 0x01, 0xe5, 0x2f, 0xb1, 0x7a, 0x17, 0x2c, 0xa8, 0x2d, 0x51, 0xc2, 0x1f, 0x42, 0x51, 0x77,
-	0x26, 0x27, 0x9c, 0xfb, 0x58, 0xde, 0x83, 0x57, 0x5d, 0xb8, 0x62, 0x74, 0x98, 0x80, 0xee, 0x4c,
-	0x38, 0x5c, 0x16, 0xde, 0x81, 0x02, 0x9d, 0xa2, 0x47, 0xc9, 0x62, 0x3e, 0x95, 0xe5, 0xf2, 0xc2,
-	0x15, 0xc3, 0x41, 0x92, 0xd7, 0x9d, 0xc9, 0x31, 0x6d, 0x49, 0x8f, 0xa1, 0xb2, 0xaf, 0x59, 0xb6,
-	0x61, 0x5e, 0xde, 0x10, 0x46, 0xfa, 0x08, 0x05, 0x9a, 0xef, 0xe2, 0x11, 0x0a, 0x8d, 0xaf, 0x86,
-	0xee, 0x87, 0x50, 0x8e, 0x89, 0xe3, 0xef, 0x41, 0x69, 0x1a, 0xf2, 0x33, 0xcb, 0x4b, 0x61, 0x21,
-	0x7c, 0xdd, 0xf8, 0x84, 0x5c, 0x7f, 0xe9, 0x8a, 0x88, 0xd2, 0x86, 0xa8, 0x34, 0x89, 0xf5, 0x68,
-	0xbe, 0x05, 0xc6, 0x27, 0xc6, 0x73, 0xf5, 0xff, 0xc8, 0xb7, 0x84, 0xfe, 0xbb, 0xc8, 0xb7, 0xe4,
-	0x12, 0xab, 0x41, 0x6b, 0x40, 0x6d, 0x89, 0x12, 0x7d, 0xc7, 0xba, 0xfe, 0xbb, 0xcc, 0x23, 0x65,
-	0xd7, 0x55, 0x38, 0xf6, 0x2e, 0xae, 0xab, 0x88, 0xf5, 0xd5, 0x8e, 0x7f, 0x0c, 0x95, 0xb8, 0xfc,
-	0xdb, 0xf3, 0x0b, 0xa9, 0x0c, 0x45, 0x76, 0x9f, 0x78, 0x91, 0xfd, 0x1c, 0x41, 0x89, 0xf7, 0x6f,
-	0x17, 0xd5, 0xa7, 0x89, 0xa8, 0xf8, 0xfd, 0xe5, 0x59, 0x5e, 0x1d, 0xd1, 0x77, 0x00, 0x42, 0x59,
-	0xfc, 0x21, 0x64, 0x75, 0x63, 0xac, 0xfa, 0x59, 0xcb, 0x6d, 0x0d, 0x28, 0x71, 0xe7, 0xb6, 0x0a,
-	0x0b, 0x57, 0xe4, 0x12, 0x84, 0x7f, 0xa4, 0x13, 0x00, 0x72, 0xd8, 0xf5, 0x13, 0x53, 0x0a, 0x78,
-	0x38, 0x0a, 0x6b, 0xa8, 0xff, 0x49, 0xbb, 0x53, 0x6f, 0x43, 0xbb, 0x7f, 0x86, 0xa0, 0xc8, 0x56,
-	0xb8, 0x1d, 0x4c, 0x9f, 0x24, 0x60, 0xaa, 0x30, 0x3d, 0x6e, 0x78, 0x35, 0x4a, 0xdf, 0x84, 0x42,
-	0x20, 0x1a, 0x10, 0x45, 0xb4, 0x8a, 0x28, 0xfe, 0x33, 0x05, 0x10, 0x82, 0x87, 0x3b, 0xd1, 0x52,
-	0xad, 0x12, 0x96, 0x6a, 0x74, 0x94, 0x17, 0x68, 0x77, 0x21, 0xa3, 0x2b, 0x13, 0x35, 0x4a, 0xbc,
-	0x68, 0x9f, 0xb0, 0x5f, 0x7a, 0xea, 0x9f, 0xab, 0xa6, 0xa5, 0x19, 0x3a, 0x2b, 0x0c, 0xbc, 0x53,
-	0xef, 0x0d, 0x11, 0xbf, 0x91, 0xbc, 0xb5, 0x33, 0x37, 0xbc, 0xb5, 0xb3, 0x6f, 0xbc, 0xb5, 0xf1,
-	0x23, 0x28, 0x31, 0x33, 0x7e, 0xce, 0xaf, 0x33, 0x71, 0x81, 0x5e, 0x64, 0xd1, 0x71, 0x42, 0x17,
-	0xf3, 0x8f, 0x0a, 0x4d, 0x0b, 0x67, 0x6a, 0x6b, 0x13, 0xb5, 0x99, 0x63, 0xe2, 0x2c, 0x2d, 0xf8,
-	0x08, 0xf1, 0xbe, 0xf8, 0x11, 0xad, 0x09, 0x6d, 0x53, 0x1b, 0x59, 0xcd, 0x3c, 0xdb, 0xa1, 0x92,
-	0x5f, 0xc3, 0xd1, 0x31, 0xbf, 0x42, 0x64, 0x1d, 0xe2, 0x37, 0xa4, 0x3f, 0x21, 0xc8, 0x79, 0x12,
-	0xf4, 0x24, 0x6a, 0xba, 0xad, 0x9a, 0xcf, 0x15, 0x7e, 0x2b, 0x22, 0x7e, 0x12, 0xfd, 0x31, 0x12,
-	0xb4, 0xf0, 0x13, 0xc8, 0xd2, 0x0d, 0xa6, 0x09, 0x48, 0xb3, 0x7c, 0x2b, 0xba, 0xd0, 0xfd, 0x3e,
-	0x9d, 0xe1, 0xa4, 0x82, 0x65, 0x3b, 0x93, 0x24, 0xfc, 0xd3, 0x7a, 0x02, 0x10, 0xce, 0x2f, 0xe1,
-	0x12, 0xf5, 0x28, 0x97, 0x40, 0x11, 0xea, 0xb0, 0xf3, 0xb7, 0x34, 0x40, 0x58, 0x8f, 0x62, 0x09,
-	0x72, 0x87, 0xc7, 0xf2, 0xd3, 0xfe, 0x70, 0x5f, 0x58, 0x6b, 0x35, 0x66, 0xf3, 0xce, 0x46, 0x38,
-	0xe9, 0x15, 0x35, 0xf8, 0x03, 0x28, 0xc8, 0xe4, 0x60, 0x77, 0xaf, 0xbb, 0x3b, 0x3c, 0x12, 0x50,
-	0x6b, 0x6b, 0x36, 0xef, 0xd4, 0x22, 0x25, 0xad, 0xcf, 0xf8, 0xf1, 0x0e, 0x14, 0x8f, 0x07, 0xc3,
-	0x63, 0x79, 0xd8, 0x25, 0x7d, 0xb9, 0x27, 0xa4, 0x5a, 0x77, 0x66, 0xf3, 0x4e, 0x23, 0x94, 0x8c,
-	0x10, 0x63, 0xbc, 0x0d, 0xb0, 0xd7, 0x1f, 0x76, 0x0f, 0x06, 0x83, 0x5e, 0xf7, 0x48, 0x48, 0xb7,
-	0x9a, 0xb3, 0x79, 0xa7, 0x1e, 0x8a, 0x86, 0x44, 0x13, 0xbf, 0x07, 0xf9, 0x43, 0xd2, 0x1b, 0xf6,
-	0x06, 0xdd, 0x9e, 0x90, 0x69, 0x6d, 0xce, 0xe6, 0x1d, 0x1c, 0x71, 0xd1, 0x63, 0x0b, 0xf8, 0x01,
-	0x54, 0x7c, 0xa9, 0x93, 0xe1, 0xd1, 0xee, 0xd1, 0x50, 0xc8, 0xb6, 0xbe, 0x32, 0x9b, 0x77, 0xb6,
-	0xae, 0xcb, 0x32, 0x66, 0x41, 0x03, 0xdf, 0xef, 0x0f, 0x8f, 0x0e, 0xc8, 0x97, 0xc2, 0x7a, 0x32,
-	0x70, 0xef, 0x4d, 0xa0, 0x46, 0x3d, 0x99, 0x13, 0xd2, 0xfb, 0xe2, 0xe0, 0x07, 0x3d, 0x21, 0x97,
-	0x34, 0x1a, 0x7b, 0x3e, 0xa8, 0xaf, 0xdd, 0xfd, 0xdd, 0xc1, 0xa0, 0xf7, 0x74, 0x28, 0xe4, 0x93,
-	0xbe, 0x06, 0x59, 0x78, 0x17, 0x32, 0xfd, 0xc1, 0xe7, 0x07, 0x42, 0xa1, 0x85, 0x67, 0xf3, 0x4e,
-	0x25, 0x94, 0x60, 0xff, 0xcc, 0xb4, 0x20, 0x4d, 0x0e, 0xbb, 0x02, 0xb4, 0x36, 0x66, 0xf3, 0x4e,
-	0x39, 0x9c, 0x24, 0x87, 0xdd, 0x56, 0xe6, 0x17, 0x7f, 0x68, 0xaf, 0x3d, 0xfc, 0x63, 0x16, 0xa0,
-	0xab, 0xea, 0xb6, 0xa9, 0x3d, 0x73, 0xce, 0x0c, 0xfc, 0x11, 0xe4, 0xfc, 0x9d, 0xaa, 0xc5, 0x6b,
-	0x57, 0x76, 0x17, 0xb6, 0xea, 0x89, 0x82, 0x96, 0x5d, 0x5f, 0xd2, 0x1a, 0xfe, 0x04, 0x0a, 0xe1,
-	0xde, 0x35, 0x92, 0xe5, 0x1e, 0xd7, 0xdd, 0xbc, 0x56, 0x05, 0xfa, 0xda, 0x32, 0x14, 0xa3, 0xfb,
-	0xb9, 0x75, 0xbd, 0x5a, 0xe2, 0x16, 0x9a, 0x4b, 0xca, 0x28, 0xdf, 0xc6, 0x67, 0x00, 0x91, 0x8d,
-	0xde, 0xbc, 0x56, 0x94, 0x70, 0x0b, 0x5b, 0xd7, 0x8b, 0x15, 0xdf, 0xc0, 0xb7, 0x21, 0x1f, 0x64,
-	0x40, 0x3d, 0x41, 0xce, 0xb9, 0x72, 0x23, 0x49, 0xd9, 0x7d, 0xd5, 0xfd, 0x90, 0x90, 0xf3, 0x84,
-	0xb8, 0xb3, 0x8c, 0xb3, 0x72, 0x23, 0xad, 0xa5, 0x74, 0xd6, 0xb7, 0xf4, 0x11, 0xe4, 0xfc, 0x84,
-	0xa9, 0xc5, 0x79, 0x48, 0x14, 0xff, 0x04, 0x51, 0xe4, 0x1e, 0xc4, 0xb3, 0xe7, 0xce, 0x32, 0x16,
-	0x13, 0xf5, 0x60, 0x29, 0x87, 0xe2, 0x30, 0x04, 0xc9, 0x55, 0x4f, 0x90, 0x89, 0x28, 0x0c, 0x49,
-	0x02, 0x23, 0xad, 0xe1, 0x7b, 0x90, 0x61, 0x59, 0x27, 0x44, 0x5e, 0x6b, 0xae, 0xb2, 0x11, 0x7d,
-	0xbf, 0x7d, 0xf1, 0x1d, 0x96, 0x9c, 0xb8, 0x1a, 0x3e, 0x5a, 0x5c, 0x58, 0x88, 0xbc, 0x62, 0x9e,
-	0xac, 0xfc, 0xde, 0x7f, 0xfe, 0xdd, 0x46, 0x7f, 0xbe, 0x6a, 0xa3, 0xbf, 0x5e, 0xb5, 0xd1, 0xcb,
-	0xab, 0x36, 0x7a, 0x75, 0xd5, 0x46, 0xff, 0xba, 0x6a, 0xa3, 0x17, 0xaf, 0xdb, 0x6b, 0xaf, 0x5e,
-	0xb7, 0xd7, 0xfe, 0xfe, 0xba, 0xbd, 0x76, 0xba, 0xce, 0xfe, 0x21, 0x7d, 0xf4, 0xdf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xf5, 0xb9, 0x50, 0xa8, 0x62, 0x15, 0x00, 0x00,
+type EngineHealthResult struct {
+	Operations []*EngineOperationHealth `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations"`
 }
 
-func (this *ClientInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*ClientInfo)
-	if !ok {
-		that2, ok := that.(ClientInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *EngineHealthResult) Reset()         { *m = EngineHealthResult{} }
+func (m *EngineHealthResult) String() string { return proto.CompactTextString(m) }
+func (*EngineHealthResult) ProtoMessage()    {}
+func (*EngineHealthResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+}
+func (m *EngineHealthResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EngineHealthResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EngineHealthResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.User != that1.User {
-		return false
-	}
-	if this.Client != that1.Client {
-		return false
-	}
-	if !this.ConnInfo.Equal(that1.ConnInfo) {
-		return false
-	}
-	if !this.ChanInfo.Equal(that1.ChanInfo) {
-		return false
-	}
-	return true
 }
-func (this *Publication) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *EngineHealthResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EngineHealthResult.Merge(m, src)
+}
+func (m *EngineHealthResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EngineHealthResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EngineHealthResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EngineHealthResult proto.InternalMessageInfo
+
+func (m *EngineHealthResult) GetOperations() []*EngineOperationHealth {
+	if m != nil {
+		return m.Operations
 	}
+	return nil
+}
 
-	that1, ok := that.(*Publication)
-	if !ok {
-		that2, ok := that.(Publication)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EngineOperationHealth struct {
+	Operation string  `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation"`
+	Count     uint32  `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	P99Ms     float64 `protobuf:"fixed64,3,opt,name=p99_ms,json=p99Ms,proto3" json:"p99_ms"`
+	ErrorRate float64 `protobuf:"fixed64,4,opt,name=error_rate,json=errorRate,proto3" json:"error_rate"`
+}
+
+func (m *EngineOperationHealth) Reset()         { *m = EngineOperationHealth{} }
+func (m *EngineOperationHealth) String() string { return proto.CompactTextString(m) }
+func (*EngineOperationHealth) ProtoMessage()    {}
+func (*EngineOperationHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+}
+func (m *EngineOperationHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EngineOperationHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EngineOperationHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.UID != that1.UID {
-		return false
+}
+func (m *EngineOperationHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EngineOperationHealth.Merge(m, src)
+}
+func (m *EngineOperationHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *EngineOperationHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_EngineOperationHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EngineOperationHealth proto.InternalMessageInfo
+
+func (m *EngineOperationHealth) GetOperation() string {
+	if m != nil {
+		return m.Operation
 	}
-	if !this.Data.Equal(that1.Data) {
-		return false
+	return ""
+}
+
+func (m *EngineOperationHealth) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	if !this.Info.Equal(that1.Info) {
-		return false
+	return 0
+}
+
+func (m *EngineOperationHealth) GetP99Ms() float64 {
+	if m != nil {
+		return m.P99Ms
 	}
-	return true
+	return 0
 }
-func (this *Error) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EngineOperationHealth) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
 	}
+	return 0
+}
 
-	that1, ok := that.(*Error)
-	if !ok {
-		that2, ok := that.(Error)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MaintenanceRequest struct {
+	Enabled        bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled"`
+	Message        string `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	BlockSubscribe bool   `protobuf:"varint,3,opt,name=block_subscribe,json=blockSubscribe,proto3" json:"block_subscribe"`
+}
+
+func (m *MaintenanceRequest) Reset()         { *m = MaintenanceRequest{} }
+func (m *MaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceRequest) ProtoMessage()    {}
+func (*MaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+}
+func (m *MaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Code != that1.Code {
-		return false
+}
+func (m *MaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceRequest.Merge(m, src)
+}
+func (m *MaintenanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceRequest proto.InternalMessageInfo
+
+func (m *MaintenanceRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if this.Message != that1.Message {
-		return false
+	return false
+}
+
+func (m *MaintenanceRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return true
+	return ""
 }
-func (this *Command) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MaintenanceRequest) GetBlockSubscribe() bool {
+	if m != nil {
+		return m.BlockSubscribe
 	}
+	return false
+}
 
-	that1, ok := that.(*Command)
-	if !ok {
-		that2, ok := that.(Command)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MaintenanceResponse struct {
+	Error  *Error             `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Result *MaintenanceResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *MaintenanceResponse) Reset()         { *m = MaintenanceResponse{} }
+func (m *MaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceResponse) ProtoMessage()    {}
+func (*MaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+}
+func (m *MaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ID != that1.ID {
-		return false
-	}
-	if this.Method != that1.Method {
-		return false
-	}
-	if !this.Params.Equal(that1.Params) {
-		return false
+}
+func (m *MaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceResponse.Merge(m, src)
+}
+func (m *MaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceResponse proto.InternalMessageInfo
+
+func (m *MaintenanceResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
 	}
-	return true
+	return nil
 }
-func (this *Reply) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MaintenanceResponse) GetResult() *MaintenanceResult {
+	if m != nil {
+		return m.Result
 	}
+	return nil
+}
 
-	that1, ok := that.(*Reply)
-	if !ok {
-		that2, ok := that.(Reply)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MaintenanceResult struct {
+	Enabled        bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled"`
+	Message        string `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	BlockSubscribe bool   `protobuf:"varint,3,opt,name=block_subscribe,json=blockSubscribe,proto3" json:"block_subscribe"`
+}
+
+func (m *MaintenanceResult) Reset()         { *m = MaintenanceResult{} }
+func (m *MaintenanceResult) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceResult) ProtoMessage()    {}
+func (*MaintenanceResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
+}
+func (m *MaintenanceResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ID != that1.ID {
-		return false
-	}
-	if !this.Error.Equal(that1.Error) {
-		return false
-	}
-	if !this.Result.Equal(that1.Result) {
-		return false
+}
+func (m *MaintenanceResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceResult.Merge(m, src)
+}
+func (m *MaintenanceResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceResult proto.InternalMessageInfo
+
+func (m *MaintenanceResult) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return true
+	return false
 }
-func (this *PublishRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MaintenanceResult) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
+	return ""
+}
 
-	that1, ok := that.(*PublishRequest)
-	if !ok {
-		that2, ok := that.(PublishRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *MaintenanceResult) GetBlockSubscribe() bool {
+	if m != nil {
+		return m.BlockSubscribe
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return false
+}
+
+type Metrics struct {
+	Interval float64            `protobuf:"fixed64,1,opt,name=interval,proto3" json:"interval"`
+	Items    map[string]float64 `protobuf:"bytes,2,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (m *Metrics) Reset()         { *m = Metrics{} }
+func (m *Metrics) String() string { return proto.CompactTextString(m) }
+func (*Metrics) ProtoMessage()    {}
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+}
+func (m *Metrics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Metrics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Metrics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Channel != that1.Channel {
-		return false
+}
+func (m *Metrics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metrics.Merge(m, src)
+}
+func (m *Metrics) XXX_Size() int {
+	return m.Size()
+}
+func (m *Metrics) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metrics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metrics proto.InternalMessageInfo
+
+func (m *Metrics) GetInterval() float64 {
+	if m != nil {
+		return m.Interval
 	}
-	if !this.Data.Equal(that1.Data) {
-		return false
+	return 0
+}
+
+func (m *Metrics) GetItems() map[string]float64 {
+	if m != nil {
+		return m.Items
 	}
-	return true
+	return nil
 }
-func (this *PublishResponse) Equal(that interface{}) bool {
+
+func init() {
+	proto.RegisterEnum("api.MethodType", MethodType_name, MethodType_value)
+	proto.RegisterType((*ClientInfo)(nil), "api.ClientInfo")
+	proto.RegisterType((*Publication)(nil), "api.Publication")
+	proto.RegisterType((*Error)(nil), "api.Error")
+	proto.RegisterType((*Command)(nil), "api.Command")
+	proto.RegisterType((*Reply)(nil), "api.Reply")
+	proto.RegisterType((*PublishRequest)(nil), "api.PublishRequest")
+	proto.RegisterType((*PublishResponse)(nil), "api.PublishResponse")
+	proto.RegisterType((*PublishResult)(nil), "api.PublishResult")
+	proto.RegisterType((*BroadcastRequest)(nil), "api.BroadcastRequest")
+	proto.RegisterType((*BroadcastResponse)(nil), "api.BroadcastResponse")
+	proto.RegisterType((*BroadcastResult)(nil), "api.BroadcastResult")
+	proto.RegisterType((*UnsubscribeRequest)(nil), "api.UnsubscribeRequest")
+	proto.RegisterType((*UnsubscribeResponse)(nil), "api.UnsubscribeResponse")
+	proto.RegisterType((*UnsubscribeResult)(nil), "api.UnsubscribeResult")
+	proto.RegisterType((*DisconnectRequest)(nil), "api.DisconnectRequest")
+	proto.RegisterType((*DisconnectResponse)(nil), "api.DisconnectResponse")
+	proto.RegisterType((*DisconnectResult)(nil), "api.DisconnectResult")
+	proto.RegisterType((*PresenceRequest)(nil), "api.PresenceRequest")
+	proto.RegisterType((*PresenceResponse)(nil), "api.PresenceResponse")
+	proto.RegisterType((*PresenceResult)(nil), "api.PresenceResult")
+	proto.RegisterMapType((map[string]*ClientInfo)(nil), "api.PresenceResult.PresenceEntry")
+	proto.RegisterType((*PresenceStatsRequest)(nil), "api.PresenceStatsRequest")
+	proto.RegisterType((*PresenceStatsResponse)(nil), "api.PresenceStatsResponse")
+	proto.RegisterType((*PresenceStatsResult)(nil), "api.PresenceStatsResult")
+	proto.RegisterType((*AggregatedPresenceStatsRequest)(nil), "api.AggregatedPresenceStatsRequest")
+	proto.RegisterType((*AggregatedPresenceStatsResponse)(nil), "api.AggregatedPresenceStatsResponse")
+	proto.RegisterType((*AggregatedPresenceStatsResult)(nil), "api.AggregatedPresenceStatsResult")
+	proto.RegisterType((*HistoryRequest)(nil), "api.HistoryRequest")
+	proto.RegisterType((*HistoryResponse)(nil), "api.HistoryResponse")
+	proto.RegisterType((*HistoryResult)(nil), "api.HistoryResult")
+	proto.RegisterType((*HistoryRemoveRequest)(nil), "api.HistoryRemoveRequest")
+	proto.RegisterType((*HistoryRemoveResponse)(nil), "api.HistoryRemoveResponse")
+	proto.RegisterType((*HistoryRemoveResult)(nil), "api.HistoryRemoveResult")
+	proto.RegisterType((*ChannelsRequest)(nil), "api.ChannelsRequest")
+	proto.RegisterType((*ChannelsResponse)(nil), "api.ChannelsResponse")
+	proto.RegisterType((*ChannelsResult)(nil), "api.ChannelsResult")
+	proto.RegisterType((*InfoRequest)(nil), "api.InfoRequest")
+	proto.RegisterType((*InfoResponse)(nil), "api.InfoResponse")
+	proto.RegisterType((*InfoResult)(nil), "api.InfoResult")
+	proto.RegisterType((*RPCRequest)(nil), "api.RPCRequest")
+	proto.RegisterType((*RPCResponse)(nil), "api.RPCResponse")
+	proto.RegisterType((*RPCResult)(nil), "api.RPCResult")
+	proto.RegisterType((*NodeResult)(nil), "api.NodeResult")
+	proto.RegisterType((*EngineHealthRequest)(nil), "api.EngineHealthRequest")
+	proto.RegisterType((*EngineHealthResponse)(nil), "api.EngineHealthResponse")
+	proto.RegisterType((*EngineHealthResult)(nil), "api.EngineHealthResult")
+	proto.RegisterType((*EngineOperationHealth)(nil), "api.EngineOperationHealth")
+	proto.RegisterType((*MaintenanceRequest)(nil), "api.MaintenanceRequest")
+	proto.RegisterType((*MaintenanceResponse)(nil), "api.MaintenanceResponse")
+	proto.RegisterType((*MaintenanceResult)(nil), "api.MaintenanceResult")
+	proto.RegisterType((*Metrics)(nil), "api.Metrics")
+	proto.RegisterMapType((map[string]float64)(nil), "api.Metrics.ItemsEntry")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 2250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x5b, 0x59,
+	0x15, 0xcf, 0xf5, 0x47, 0x62, 0x1f, 0x7f, 0xc4, 0xb9, 0xce, 0x87, 0xfb, 0x28, 0x7e, 0x9e, 0x47,
+	0x5b, 0x4a, 0x98, 0xb6, 0xa3, 0x16, 0x66, 0xda, 0x51, 0xcb, 0x60, 0x3b, 0x9e, 0x26, 0x33, 0xad,
+	0x13, 0x5d, 0x27, 0x48, 0x23, 0x16, 0xe6, 0xc5, 0x7e, 0x49, 0x9e, 0x26, 0x7e, 0xcf, 0xbc, 0xf7,
+	0x5c, 0xc8, 0x16, 0xb1, 0x40, 0x06, 0xa1, 0x11, 0x42, 0xb0, 0x40, 0xd1, 0x20, 0x81, 0x04, 0x82,
+	0x1d, 0x2b, 0x96, 0x2c, 0xbb, 0x9c, 0x25, 0x62, 0x61, 0x98, 0x74, 0xe7, 0xbf, 0x60, 0x96, 0xe8,
+	0x7e, 0xbc, 0x4f, 0xbb, 0x71, 0x43, 0x54, 0x34, 0x1b, 0xbf, 0x7b, 0xcf, 0x3d, 0xe7, 0xdc, 0x73,
+	0x7e, 0xe7, 0xdc, 0x8f, 0x73, 0x0d, 0x69, 0xb5, 0xaf, 0xdf, 0xee, 0x5b, 0xa6, 0x63, 0xe2, 0xb8,
+	0xda, 0xd7, 0xa5, 0x5b, 0x87, 0xba, 0x73, 0x34, 0xd8, 0xbf, 0xdd, 0x31, 0x7b, 0x77, 0x0e, 0xcd,
+	0x43, 0xf3, 0x0e, 0x1b, 0xdb, 0x1f, 0x1c, 0xb0, 0x1e, 0xeb, 0xb0, 0x16, 0x97, 0x51, 0xbe, 0x40,
+	0x00, 0xf5, 0x63, 0x5d, 0x33, 0x9c, 0x2d, 0xe3, 0xc0, 0xc4, 0x57, 0x21, 0x31, 0xb0, 0x35, 0xab,
+	0x84, 0x2a, 0xe8, 0x66, 0xba, 0x96, 0x1a, 0x8f, 0x64, 0xd6, 0x27, 0xec, 0x17, 0x2b, 0x30, 0xdf,
+	0x61, 0xbc, 0xa5, 0x18, 0x1b, 0x87, 0xf1, 0x48, 0x16, 0x14, 0x22, 0xbe, 0xf8, 0x3d, 0x48, 0x77,
+	0x4c, 0xc3, 0x68, 0xeb, 0xc6, 0x81, 0x59, 0x8a, 0x57, 0xd0, 0xcd, 0x6c, 0x4d, 0x79, 0x3e, 0x92,
+	0xe7, 0xfe, 0x35, 0x92, 0xe3, 0x44, 0xfd, 0xd1, 0x78, 0x24, 0x17, 0xbd, 0xf1, 0x37, 0xcd, 0x9e,
+	0xee, 0x68, 0xbd, 0xbe, 0x73, 0x42, 0x52, 0x94, 0xc8, 0x4c, 0xa0, 0x0a, 0x8e, 0x54, 0xa1, 0x20,
+	0x31, 0x5d, 0xc1, 0x91, 0x3a, 0x45, 0xc1, 0x91, 0xca, 0x15, 0xdc, 0x80, 0x84, 0x61, 0x76, 0xb5,
+	0x52, 0x92, 0xd9, 0x88, 0xc7, 0x23, 0x39, 0x4f, 0xfb, 0x01, 0x5e, 0x36, 0xae, 0xfc, 0x0e, 0x41,
+	0x66, 0x67, 0xb0, 0x7f, 0xac, 0x77, 0x54, 0x47, 0x37, 0x0d, 0xbc, 0x0e, 0xf1, 0x81, 0xde, 0x15,
+	0xae, 0x97, 0xce, 0x46, 0x72, 0x7c, 0x6f, 0x6b, 0x63, 0x3c, 0x92, 0x73, 0x03, 0xbd, 0x1b, 0x10,
+	0xa6, 0x4c, 0xf8, 0xeb, 0x90, 0xe8, 0xaa, 0x8e, 0xca, 0x70, 0xc8, 0xd6, 0x8a, 0x61, 0xfb, 0xd8,
+	0x10, 0x61, 0xbf, 0xf8, 0x1d, 0x48, 0x78, 0x48, 0x64, 0xee, 0x2e, 0xde, 0xa6, 0xd1, 0xf2, 0xf1,
+	0xe6, 0xd6, 0x45, 0x3c, 0x61, 0x02, 0xca, 0x13, 0x48, 0x36, 0x2c, 0xcb, 0xb4, 0x68, 0x48, 0x3a,
+	0xd4, 0x1d, 0x6a, 0x57, 0x8e, 0x87, 0x84, 0xf6, 0x09, 0xfb, 0xc5, 0xd7, 0x61, 0xa1, 0xa7, 0xd9,
+	0xb6, 0x7a, 0xa8, 0x89, 0x98, 0x64, 0xc6, 0x23, 0xd9, 0x25, 0x11, 0xb7, 0xa1, 0xfc, 0x1c, 0xc1,
+	0x42, 0xdd, 0xec, 0xf5, 0x54, 0xa3, 0x8b, 0xaf, 0x42, 0x4c, 0xb8, 0x99, 0xab, 0x65, 0xcf, 0x46,
+	0x72, 0x8c, 0x79, 0x19, 0xd3, 0xbb, 0x24, 0xa6, 0x77, 0xf1, 0x3d, 0x98, 0xef, 0x69, 0xce, 0x91,
+	0xd9, 0x65, 0xfa, 0xf2, 0xc2, 0xe4, 0xa7, 0x8c, 0xb4, 0x7b, 0xd2, 0xd7, 0x78, 0xd0, 0x39, 0x0b,
+	0x11, 0x5f, 0x7c, 0x0b, 0xe6, 0xfb, 0xaa, 0xa5, 0xf6, 0x6c, 0x11, 0xf1, 0x95, 0x30, 0x20, 0x62,
+	0x90, 0x88, 0xaf, 0xf2, 0x29, 0x82, 0x24, 0xd1, 0xfa, 0xc7, 0x27, 0xf8, 0x46, 0xc0, 0x96, 0x55,
+	0xcf, 0x96, 0x6c, 0x08, 0x70, 0x6a, 0xd5, 0xb7, 0x21, 0xa9, 0x51, 0x34, 0x98, 0x51, 0x99, 0xbb,
+	0xc0, 0x8c, 0x62, 0xf8, 0xd4, 0x8a, 0xe3, 0x91, 0xbc, 0xc8, 0x06, 0x03, 0x32, 0x9c, 0x1b, 0xbf,
+	0x03, 0xf3, 0x96, 0x66, 0x0f, 0x8e, 0x1d, 0x61, 0x97, 0x1c, 0xb6, 0xab, 0xc0, 0x07, 0x03, 0x72,
+	0x82, 0x5d, 0x19, 0x21, 0xc8, 0xb3, 0xdc, 0xb0, 0x8f, 0x88, 0xf6, 0xc3, 0x81, 0x66, 0x3b, 0x14,
+	0x69, 0x9a, 0x62, 0x86, 0x76, 0x5c, 0x42, 0x3e, 0xd2, 0x82, 0x44, 0xdc, 0xc6, 0xab, 0x67, 0xc6,
+	0x23, 0xc8, 0x76, 0x4c, 0xc3, 0xd1, 0x0c, 0xa7, 0xed, 0x9c, 0xf4, 0x35, 0x66, 0x61, 0xba, 0x26,
+	0x8d, 0x47, 0xf2, 0x6a, 0x90, 0x1e, 0x30, 0x2e, 0x23, 0xe8, 0x34, 0x0c, 0x54, 0xdc, 0xb4, 0xba,
+	0x9a, 0xa5, 0x1b, 0x87, 0xed, 0x8f, 0xb5, 0x93, 0x52, 0xc2, 0x17, 0x0f, 0xd2, 0x83, 0xe2, 0x2e,
+	0xfd, 0x43, 0xed, 0x44, 0x19, 0x22, 0x58, 0xf4, 0x1c, 0xb4, 0xfb, 0xa6, 0x61, 0x6b, 0x3e, 0xc8,
+	0xe8, 0x42, 0x20, 0x7f, 0xd7, 0x03, 0x99, 0x07, 0x07, 0x33, 0x39, 0x5f, 0xf9, 0xe0, 0xd8, 0xa9,
+	0x2d, 0x9f, 0x8b, 0xf6, 0x22, 0xe4, 0x42, 0xec, 0xca, 0x1f, 0x11, 0x14, 0x6a, 0x96, 0xa9, 0x76,
+	0x3b, 0xaa, 0xed, 0xb8, 0x01, 0xb8, 0x09, 0x29, 0x01, 0xb2, 0x5d, 0x42, 0x95, 0xf8, 0xcd, 0x74,
+	0x2d, 0x3b, 0x1e, 0xc9, 0x1e, 0x8d, 0x78, 0xad, 0xff, 0x57, 0x0c, 0x94, 0x5f, 0x22, 0x58, 0x0a,
+	0x98, 0x79, 0x39, 0x18, 0x6b, 0x11, 0x18, 0x97, 0x99, 0x5c, 0x50, 0xfd, 0x6c, 0x20, 0x97, 0x60,
+	0x31, 0x22, 0xa0, 0x7c, 0x04, 0x78, 0xcf, 0xb0, 0x07, 0xfb, 0x76, 0xc7, 0xd2, 0xf7, 0xb5, 0x0b,
+	0x26, 0xb3, 0x7b, 0x1c, 0xc4, 0xa6, 0x1d, 0x07, 0xca, 0xaf, 0x10, 0x14, 0x43, 0xba, 0x2f, 0x07,
+	0xc0, 0x46, 0x04, 0x80, 0x55, 0x26, 0x17, 0x9e, 0x60, 0x36, 0x04, 0x45, 0x58, 0x9a, 0x10, 0x51,
+	0xda, 0xb0, 0xb4, 0xa1, 0xdb, 0xf4, 0x88, 0xd1, 0x3a, 0x5e, 0x3e, 0x9d, 0x7f, 0xd6, 0xbd, 0x49,
+	0xad, 0x51, 0x6d, 0xd3, 0x10, 0xce, 0x8b, 0x59, 0x29, 0x25, 0x3c, 0x2b, 0xa5, 0x28, 0x9f, 0x20,
+	0xc0, 0xc1, 0x19, 0x2e, 0x87, 0x44, 0x3d, 0x82, 0xc4, 0x0a, 0x93, 0x0b, 0xe9, 0x9f, 0x0d, 0x04,
+	0x86, 0x42, 0x54, 0x42, 0xb9, 0x0f, 0x8b, 0x3b, 0x96, 0x66, 0x6b, 0x46, 0xe7, 0x82, 0x99, 0xa0,
+	0xfc, 0x02, 0x41, 0xc1, 0x17, 0xbd, 0x9c, 0x7b, 0xd5, 0x88, 0x7b, 0x45, 0xbe, 0x61, 0xf8, 0xda,
+	0x67, 0x3b, 0xf7, 0x57, 0xba, 0x3f, 0x87, 0x04, 0xf0, 0x87, 0x90, 0xea, 0x0b, 0x0a, 0xdb, 0x1e,
+	0x32, 0x77, 0xdf, 0x98, 0xa2, 0xd7, 0xeb, 0x36, 0x0c, 0xc7, 0x3a, 0xe1, 0x3b, 0x88, 0x2b, 0x46,
+	0xbc, 0x96, 0xf4, 0x04, 0x72, 0x21, 0x46, 0x5c, 0x80, 0x38, 0xdd, 0x65, 0x19, 0x44, 0x84, 0x36,
+	0xf1, 0x75, 0x48, 0x3e, 0x53, 0x8f, 0x07, 0x9a, 0x70, 0x22, 0x7a, 0xb4, 0x13, 0x3e, 0xfa, 0x6e,
+	0xec, 0x3e, 0x52, 0x1e, 0xc1, 0xb2, 0xab, 0xad, 0xe5, 0xa8, 0x8e, 0x7d, 0x41, 0xec, 0x7f, 0x83,
+	0x60, 0x25, 0x22, 0x7f, 0xb9, 0x00, 0xbc, 0x1f, 0x09, 0x40, 0x29, 0x04, 0x94, 0x3b, 0xc5, 0xec,
+	0x28, 0xd8, 0x50, 0x9c, 0x22, 0x84, 0xdf, 0x82, 0x8c, 0x31, 0xe8, 0xb5, 0xf9, 0x85, 0xd0, 0x16,
+	0xa7, 0xfb, 0xe2, 0x78, 0x24, 0x07, 0xc9, 0x04, 0x8c, 0x41, 0x8f, 0xc3, 0x65, 0xe3, 0x75, 0x48,
+	0xd3, 0x21, 0xba, 0xf0, 0x6c, 0x66, 0x53, 0xae, 0x96, 0x1b, 0x8f, 0x64, 0x9f, 0x48, 0x52, 0xc6,
+	0xa0, 0xb7, 0x47, 0x5b, 0xca, 0x06, 0x94, 0xab, 0x87, 0x87, 0x96, 0x76, 0xa8, 0x3a, 0x5a, 0x77,
+	0x2a, 0xac, 0x0a, 0xcc, 0xf7, 0x2d, 0xed, 0x40, 0xff, 0xb1, 0x40, 0x95, 0xdd, 0x58, 0x38, 0x85,
+	0x88, 0xaf, 0xf2, 0x17, 0x04, 0xf2, 0x4b, 0xd5, 0x5c, 0x0e, 0xdd, 0x9d, 0x08, 0xba, 0x0a, 0x93,
+	0x7b, 0xf9, 0x64, 0xb3, 0x71, 0xfe, 0x1b, 0x82, 0xaf, 0x9e, 0x2b, 0x8f, 0xef, 0x41, 0x96, 0x61,
+	0xeb, 0x9f, 0x8f, 0x14, 0xc3, 0x02, 0xbd, 0x4b, 0x05, 0xe9, 0x84, 0x46, 0xa0, 0x2e, 0x3a, 0xd1,
+	0x38, 0xc5, 0x2e, 0x18, 0xa7, 0xf8, 0xf9, 0x71, 0xda, 0x87, 0xfc, 0xa6, 0x6e, 0x3b, 0xa6, 0x75,
+	0x72, 0xc1, 0x43, 0xe7, 0x1b, 0x90, 0xb4, 0x75, 0xba, 0x8a, 0xa9, 0x41, 0x71, 0x0e, 0x35, 0x23,
+	0x04, 0xa1, 0x66, 0x04, 0x76, 0x8b, 0xf1, 0x26, 0x79, 0x1d, 0xb7, 0x18, 0x5f, 0xf9, 0xec, 0x28,
+	0x7d, 0x1f, 0x72, 0x21, 0x76, 0xfc, 0x01, 0x64, 0xfb, 0x7e, 0x7d, 0x61, 0x8b, 0x5d, 0xa9, 0xe0,
+	0x5f, 0x8f, 0xf8, 0x40, 0x6d, 0xf9, 0xf9, 0x48, 0x46, 0x34, 0x54, 0x41, 0x6e, 0x12, 0xea, 0xd1,
+	0x2d, 0xc4, 0x53, 0xde, 0x33, 0x9f, 0x69, 0xff, 0xc3, 0x16, 0x12, 0x91, 0x7f, 0x1d, 0x5b, 0x48,
+	0x74, 0x8a, 0xd9, 0xa0, 0xad, 0x40, 0x71, 0x8a, 0x10, 0xbd, 0xc8, 0xb8, 0x69, 0x2a, 0x3c, 0x65,
+	0x27, 0x90, 0x4f, 0x7b, 0x1d, 0x27, 0x50, 0x40, 0xfb, 0x6c, 0xc3, 0xdf, 0x85, 0x7c, 0x98, 0xff,
+	0xd5, 0xef, 0xa7, 0x4a, 0x0e, 0x32, 0xec, 0x88, 0x10, 0x9e, 0xfd, 0x14, 0x41, 0x96, 0xf7, 0x2f,
+	0xe7, 0xd5, 0xa3, 0x88, 0x57, 0xfc, 0x48, 0x12, 0x9a, 0x67, 0x7b, 0xf4, 0x1d, 0x00, 0x9f, 0x17,
+	0xbf, 0x05, 0x49, 0x5a, 0x25, 0xbb, 0x59, 0xcb, 0x75, 0x35, 0x69, 0xe1, 0xc9, 0x75, 0xa5, 0xc7,
+	0x23, 0x99, 0x73, 0x10, 0xfe, 0x51, 0xda, 0x00, 0x64, 0xa7, 0x1e, 0xd8, 0x84, 0x45, 0x1d, 0x19,
+	0xd8, 0x84, 0x5f, 0x5a, 0x36, 0xc6, 0x5e, 0xa5, 0x6c, 0xfc, 0x09, 0x82, 0x0c, 0x9b, 0xe1, 0x72,
+	0x30, 0x3d, 0x8c, 0xc0, 0x94, 0x67, 0x72, 0x5c, 0xf1, 0x6c, 0x94, 0xbe, 0x05, 0x69, 0x8f, 0xd5,
+	0x2b, 0x34, 0xd0, 0x8c, 0x42, 0x43, 0xf9, 0x77, 0x0c, 0xc0, 0x07, 0x0f, 0x57, 0x82, 0x4f, 0x0d,
+	0x79, 0xff, 0xa9, 0x81, 0x52, 0xf9, 0x03, 0xc3, 0x55, 0x48, 0x18, 0x6a, 0x4f, 0x0b, 0xde, 0xbc,
+	0x69, 0x9f, 0xb0, 0x5f, 0xba, 0xea, 0x9f, 0x69, 0x96, 0xad, 0x9b, 0x46, 0x29, 0xee, 0xaf, 0x7a,
+	0x41, 0x22, 0x6e, 0x23, 0xba, 0xc1, 0x27, 0x2e, 0xb8, 0xc1, 0x27, 0xcf, 0xdd, 0xe0, 0x27, 0xce,
+	0x9c, 0xf9, 0x57, 0x39, 0x73, 0x14, 0x98, 0x1f, 0xf4, 0x1d, 0xbd, 0xa7, 0x95, 0x16, 0x18, 0x3b,
+	0x4b, 0x0b, 0x4e, 0x21, 0xe2, 0x8b, 0xef, 0xd1, 0x37, 0x0d, 0xc7, 0xd2, 0x3b, 0x76, 0x29, 0xc5,
+	0x22, 0x94, 0x75, 0xdf, 0x20, 0x28, 0xcd, 0x7d, 0xe1, 0x60, 0x1d, 0xe2, 0x36, 0xe8, 0x46, 0xd2,
+	0x30, 0x0e, 0x75, 0x43, 0xdb, 0xd4, 0xd4, 0x63, 0xc7, 0xad, 0xda, 0x95, 0x5f, 0x23, 0x58, 0x0e,
+	0xd3, 0x2f, 0x97, 0x3c, 0x8d, 0x48, 0xf2, 0xac, 0x71, 0xb9, 0xf0, 0x0c, 0xb3, 0xb3, 0xe8, 0x07,
+	0x80, 0x27, 0x65, 0xf0, 0x07, 0x00, 0x66, 0x5f, 0xb3, 0x42, 0xc7, 0x85, 0x14, 0x98, 0x60, 0xdb,
+	0x1d, 0xe4, 0x52, 0xb5, 0xfc, 0x78, 0x24, 0x07, 0x24, 0x48, 0xa0, 0xad, 0xfc, 0x03, 0xc1, 0xca,
+	0x54, 0x29, 0xfc, 0x4d, 0x48, 0x7b, 0x7c, 0x22, 0x05, 0x59, 0x8c, 0x3d, 0x22, 0xf1, 0x9b, 0x58,
+	0x86, 0x64, 0xc7, 0x1c, 0x88, 0x17, 0xbf, 0x1c, 0x5f, 0xf5, 0x8c, 0x40, 0xf8, 0x07, 0xaf, 0xc3,
+	0x7c, 0xff, 0xc1, 0x83, 0xb6, 0x78, 0xfa, 0x41, 0xb5, 0xe2, 0xd9, 0x48, 0x4e, 0xee, 0x3c, 0x78,
+	0xf0, 0xd4, 0x66, 0x2b, 0x98, 0x0d, 0x91, 0x64, 0x9f, 0x12, 0xf0, 0x2d, 0x00, 0x86, 0x62, 0xdb,
+	0x52, 0x1d, 0x8d, 0xa5, 0x23, 0xe2, 0x3e, 0xf8, 0x54, 0x92, 0x66, 0x6d, 0xa2, 0x3a, 0x9a, 0xf2,
+	0x7b, 0x04, 0xf8, 0xa9, 0xaa, 0xd3, 0x7a, 0x5b, 0x0d, 0x57, 0x2c, 0x9a, 0xa1, 0xee, 0x1f, 0x6b,
+	0x7c, 0x01, 0xa5, 0x78, 0x42, 0x08, 0x12, 0x71, 0x1b, 0xaf, 0xf8, 0x32, 0x86, 0x1f, 0xc2, 0xe2,
+	0xfe, 0xb1, 0xd9, 0xf9, 0xb8, 0xed, 0xd5, 0x8c, 0xcc, 0x91, 0x14, 0xcf, 0x82, 0xc8, 0x10, 0xc9,
+	0x33, 0x42, 0xcb, 0xed, 0xb3, 0x12, 0x38, 0x64, 0xe2, 0xeb, 0x28, 0x81, 0xc3, 0x13, 0xcc, 0x4e,
+	0xae, 0x4f, 0x11, 0x2c, 0x4d, 0xc8, 0x7c, 0xa9, 0x60, 0xfb, 0x13, 0x82, 0x05, 0xb1, 0x9c, 0xe9,
+	0xb1, 0x49, 0x4d, 0xb5, 0x9e, 0xa9, 0xfc, 0x0a, 0x83, 0xf8, 0xb1, 0xe9, 0xd2, 0x88, 0xd7, 0xc2,
+	0xf7, 0x21, 0x49, 0x5d, 0xa5, 0xa7, 0x45, 0xdc, 0x5b, 0x7a, 0x42, 0xcd, 0xed, 0x2d, 0x3a, 0xc2,
+	0x8b, 0x3a, 0x96, 0xa4, 0x8c, 0x93, 0xf0, 0x8f, 0x74, 0x1f, 0xc0, 0x1f, 0x9f, 0x52, 0xcb, 0x2d,
+	0x07, 0x6b, 0x39, 0x14, 0x28, 0xdd, 0xd6, 0x3f, 0x4f, 0x00, 0xf8, 0x8f, 0x9f, 0x58, 0x81, 0x85,
+	0x9d, 0xbd, 0xda, 0x93, 0xad, 0xd6, 0x66, 0x61, 0x4e, 0x5a, 0x19, 0x9e, 0x56, 0x96, 0xfc, 0x41,
+	0xf1, 0x84, 0x85, 0x6f, 0x40, 0xba, 0x46, 0xb6, 0xab, 0x1b, 0xf5, 0x6a, 0x6b, 0xb7, 0x80, 0xa4,
+	0xb5, 0xe1, 0x69, 0xa5, 0xe8, 0x73, 0x79, 0xef, 0x33, 0x78, 0x1d, 0x32, 0x7b, 0xcd, 0xd6, 0x5e,
+	0xad, 0x55, 0x27, 0x5b, 0xb5, 0x46, 0x21, 0x26, 0x5d, 0x19, 0x9e, 0x56, 0x56, 0x7c, 0xce, 0xc0,
+	0x33, 0x06, 0xbe, 0x09, 0xb0, 0xb1, 0xd5, 0xaa, 0x6f, 0x37, 0x9b, 0x8d, 0xfa, 0x6e, 0x21, 0x2e,
+	0x95, 0x86, 0xa7, 0x95, 0x65, 0x9f, 0xd5, 0x2f, 0xf4, 0xf1, 0x35, 0x48, 0xed, 0x90, 0x46, 0xab,
+	0xd1, 0xac, 0x37, 0x0a, 0x09, 0x69, 0x75, 0x78, 0x5a, 0xc1, 0x01, 0x13, 0x45, 0xe9, 0x80, 0xef,
+	0x40, 0xde, 0xe5, 0x6a, 0xb7, 0x76, 0xab, 0xbb, 0xad, 0x42, 0x52, 0xfa, 0xca, 0xf0, 0xb4, 0xb2,
+	0x36, 0xc9, 0xcb, 0xca, 0x0c, 0xea, 0xf8, 0xe6, 0x56, 0x6b, 0x77, 0x9b, 0x7c, 0x54, 0x98, 0x8f,
+	0x3a, 0x2e, 0x2e, 0x70, 0x54, 0xa9, 0xe0, 0x69, 0x93, 0xc6, 0xd3, 0xed, 0xef, 0x35, 0x0a, 0x0b,
+	0x51, 0xa5, 0xa1, 0xbb, 0x1e, 0xb5, 0xb5, 0xbe, 0x59, 0x6d, 0x36, 0x1b, 0x4f, 0x5a, 0x85, 0x54,
+	0xd4, 0x56, 0xef, 0xc8, 0xb8, 0x0a, 0x89, 0xad, 0xe6, 0xfb, 0xdb, 0x85, 0xb4, 0x84, 0x87, 0xa7,
+	0x95, 0xbc, 0xcf, 0xc1, 0x5e, 0xfb, 0x25, 0x88, 0x93, 0x9d, 0x7a, 0x01, 0xa4, 0xa5, 0xe1, 0x69,
+	0x25, 0xe7, 0x0f, 0x92, 0x9d, 0x3a, 0xde, 0x80, 0x2b, 0xd5, 0xc7, 0x8f, 0x49, 0xe3, 0x71, 0x75,
+	0xb7, 0xb1, 0xd1, 0x8e, 0x38, 0x9c, 0x91, 0xae, 0x0f, 0x4f, 0x2b, 0x6f, 0xf8, 0x12, 0x2f, 0xa9,
+	0xb0, 0xf0, 0x2d, 0xc8, 0x35, 0x9a, 0x8f, 0xb7, 0x9a, 0x8d, 0xf6, 0x66, 0xa3, 0xfa, 0x64, 0x77,
+	0xb3, 0x90, 0x95, 0xa4, 0xe1, 0x69, 0x65, 0xd5, 0x97, 0x0c, 0x6e, 0xe5, 0x34, 0xac, 0x4f, 0xab,
+	0x5b, 0xcd, 0xdd, 0x46, 0xb3, 0x4a, 0x63, 0x90, 0x8b, 0x86, 0x35, 0xb0, 0x32, 0xa5, 0xc4, 0xcf,
+	0xfe, 0x50, 0x9e, 0xbb, 0xfb, 0xdb, 0x05, 0x80, 0xba, 0x66, 0x38, 0x96, 0x7e, 0x30, 0x38, 0x34,
+	0xf1, 0xdb, 0xb0, 0xe0, 0xa6, 0x52, 0x31, 0xfc, 0x94, 0xca, 0xf6, 0x3f, 0x69, 0x39, 0x4c, 0xe4,
+	0x3b, 0x8e, 0x32, 0x87, 0x1f, 0x42, 0xda, 0x4f, 0xae, 0x95, 0xe8, 0xeb, 0x21, 0x97, 0x5d, 0x8d,
+	0x92, 0x3d, 0xe9, 0x1a, 0x64, 0x82, 0x09, 0xb7, 0x36, 0xf9, 0xf8, 0xc6, 0x35, 0x94, 0x26, 0x07,
+	0x3c, 0x1d, 0xef, 0x01, 0x04, 0x32, 0x71, 0x75, 0xe2, 0xd5, 0x8a, 0x6b, 0x58, 0x9b, 0xa0, 0x7b,
+	0x0a, 0x1e, 0x40, 0xca, 0x4b, 0xd1, 0xe5, 0xc8, 0xeb, 0x0d, 0x17, 0x5e, 0x89, 0x50, 0x3d, 0xd1,
+	0x4d, 0xc8, 0x85, 0xc3, 0x76, 0x65, 0xda, 0xa3, 0x06, 0x57, 0x22, 0x4d, 0x1b, 0xf2, 0x34, 0x1d,
+	0xc0, 0xda, 0xcb, 0x52, 0xe1, 0x6b, 0xe7, 0x97, 0xf2, 0x5c, 0xfb, 0xb5, 0xf3, 0x99, 0xbc, 0x79,
+	0xde, 0x86, 0x05, 0x77, 0xe5, 0x14, 0xc3, 0xd5, 0x53, 0x30, 0xce, 0x91, 0xf2, 0x96, 0x7b, 0x1a,
+	0x5e, 0x46, 0x57, 0xa6, 0xd5, 0x5e, 0x41, 0x4f, 0xa7, 0x56, 0x7e, 0x1c, 0x6e, 0x6f, 0x95, 0x2d,
+	0x47, 0x4a, 0xa0, 0x20, 0xdc, 0xd1, 0xb2, 0x4b, 0x99, 0xc3, 0xb7, 0x20, 0xc1, 0x96, 0x5f, 0x21,
+	0x50, 0x63, 0x70, 0x91, 0xa5, 0x00, 0xc5, 0x63, 0x6f, 0x40, 0x36, 0xb4, 0x48, 0x4a, 0x53, 0xae,
+	0x4d, 0x5c, 0xfc, 0xca, 0x94, 0x91, 0x60, 0x92, 0x06, 0x96, 0x8f, 0x48, 0xd2, 0xc9, 0x2b, 0x82,
+	0x54, 0x9a, 0x1c, 0xf0, 0x74, 0xac, 0xb3, 0x0d, 0x03, 0x2f, 0xfa, 0xb7, 0x7e, 0x2e, 0x53, 0xf0,
+	0x09, 0x2e, 0x6f, 0xed, 0xda, 0x17, 0x9f, 0x97, 0xd1, 0x9f, 0xcf, 0xca, 0xe8, 0xef, 0x67, 0x65,
+	0xf4, 0xfc, 0xac, 0x8c, 0x3e, 0x3b, 0x2b, 0xa3, 0xff, 0x9c, 0x95, 0xd1, 0x27, 0x2f, 0xca, 0x73,
+	0x9f, 0xbd, 0x28, 0xcf, 0xfd, 0xf3, 0x45, 0x79, 0x6e, 0x7f, 0x9e, 0xfd, 0x95, 0x7a, 0xef, 0xbf,
+	0x03, 0x00, 0x0a, 0x39, 0x13, 0xbc, 0x8b, 0x1d, 0x00, 0x00,
+}
+
+func (this *ClientInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PublishResponse)
+	that1, ok := that.(*ClientInfo)
 	if !ok {
-		that2, ok := that.(PublishResponse)
+		that2, ok := that.(ClientInfo)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2350,22 +2800,31 @@ func (this *PublishResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Error.Equal(that1.Error) {
+	if this.User != that1.User {
 		return false
 	}
-	if !this.Result.Equal(that1.Result) {
+	if this.Client != that1.Client {
+		return false
+	}
+	if !this.ConnInfo.Equal(that1.ConnInfo) {
+		return false
+	}
+	if !this.ChanInfo.Equal(that1.ChanInfo) {
+		return false
+	}
+	if this.Node != that1.Node {
 		return false
 	}
 	return true
 }
-func (this *PublishResult) Equal(that interface{}) bool {
+func (this *Publication) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PublishResult)
+	that1, ok := that.(*Publication)
 	if !ok {
-		that2, ok := that.(PublishResult)
+		that2, ok := that.(Publication)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2377,16 +2836,25 @@ func (this *PublishResult) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.UID != that1.UID {
+		return false
+	}
+	if !this.Data.Equal(that1.Data) {
+		return false
+	}
+	if !this.Info.Equal(that1.Info) {
+		return false
+	}
 	return true
 }
-func (this *BroadcastRequest) Equal(that interface{}) bool {
+func (this *Error) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*BroadcastRequest)
+	that1, ok := that.(*Error)
 	if !ok {
-		that2, ok := that.(BroadcastRequest)
+		that2, ok := that.(Error)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2398,27 +2866,22 @@ func (this *BroadcastRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Channels) != len(that1.Channels) {
+	if this.Code != that1.Code {
 		return false
 	}
-	for i := range this.Channels {
-		if this.Channels[i] != that1.Channels[i] {
-			return false
-		}
-	}
-	if !this.Data.Equal(that1.Data) {
+	if this.Message != that1.Message {
 		return false
 	}
 	return true
 }
-func (this *BroadcastResponse) Equal(that interface{}) bool {
+func (this *Command) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*BroadcastResponse)
+	that1, ok := that.(*Command)
 	if !ok {
-		that2, ok := that.(BroadcastResponse)
+		that2, ok := that.(Command)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2430,22 +2893,25 @@ func (this *BroadcastResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Error.Equal(that1.Error) {
+	if this.ID != that1.ID {
 		return false
 	}
-	if !this.Result.Equal(that1.Result) {
+	if this.Method != that1.Method {
+		return false
+	}
+	if !this.Params.Equal(that1.Params) {
 		return false
 	}
 	return true
 }
-func (this *BroadcastResult) Equal(that interface{}) bool {
+func (this *Reply) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*BroadcastResult)
+	that1, ok := that.(*Reply)
 	if !ok {
-		that2, ok := that.(BroadcastResult)
+		that2, ok := that.(Reply)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2457,16 +2923,25 @@ func (this *BroadcastResult) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
 	return true
 }
-func (this *UnsubscribeRequest) Equal(that interface{}) bool {
+func (this *PublishRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UnsubscribeRequest)
+	that1, ok := that.(*PublishRequest)
 	if !ok {
-		that2, ok := that.(UnsubscribeRequest)
+		that2, ok := that.(PublishRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2481,19 +2956,25 @@ func (this *UnsubscribeRequest) Equal(that interface{}) bool {
 	if this.Channel != that1.Channel {
 		return false
 	}
-	if this.User != that1.User {
+	if !this.Data.Equal(that1.Data) {
+		return false
+	}
+	if this.ContentType != that1.ContentType {
+		return false
+	}
+	if this.OrderingKey != that1.OrderingKey {
 		return false
 	}
 	return true
 }
-func (this *UnsubscribeResponse) Equal(that interface{}) bool {
+func (this *PublishResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UnsubscribeResponse)
+	that1, ok := that.(*PublishResponse)
 	if !ok {
-		that2, ok := that.(UnsubscribeResponse)
+		that2, ok := that.(PublishResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2513,14 +2994,14 @@ func (this *UnsubscribeResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *UnsubscribeResult) Equal(that interface{}) bool {
+func (this *PublishResult) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UnsubscribeResult)
+	that1, ok := that.(*PublishResult)
 	if !ok {
-		that2, ok := that.(UnsubscribeResult)
+		that2, ok := that.(PublishResult)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2534,14 +3015,14 @@ func (this *UnsubscribeResult) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DisconnectRequest) Equal(that interface{}) bool {
+func (this *BroadcastRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DisconnectRequest)
+	that1, ok := that.(*BroadcastRequest)
 	if !ok {
-		that2, ok := that.(DisconnectRequest)
+		that2, ok := that.(BroadcastRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2553,19 +3034,30 @@ func (this *DisconnectRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.User != that1.User {
+	if len(this.Channels) != len(that1.Channels) {
+		return false
+	}
+	for i := range this.Channels {
+		if this.Channels[i] != that1.Channels[i] {
+			return false
+		}
+	}
+	if !this.Data.Equal(that1.Data) {
+		return false
+	}
+	if this.ContentType != that1.ContentType {
 		return false
 	}
 	return true
 }
-func (this *DisconnectResponse) Equal(that interface{}) bool {
+func (this *BroadcastResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DisconnectResponse)
+	that1, ok := that.(*BroadcastResponse)
 	if !ok {
-		that2, ok := that.(DisconnectResponse)
+		that2, ok := that.(BroadcastResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2585,14 +3077,14 @@ func (this *DisconnectResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DisconnectResult) Equal(that interface{}) bool {
+func (this *BroadcastResult) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DisconnectResult)
+	that1, ok := that.(*BroadcastResult)
 	if !ok {
-		that2, ok := that.(DisconnectResult)
+		that2, ok := that.(BroadcastResult)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2606,14 +3098,14 @@ func (this *DisconnectResult) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *PresenceRequest) Equal(that interface{}) bool {
+func (this *UnsubscribeRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PresenceRequest)
+	that1, ok := that.(*UnsubscribeRequest)
 	if !ok {
-		that2, ok := that.(PresenceRequest)
+		that2, ok := that.(UnsubscribeRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2628,8 +3120,158 @@ func (this *PresenceRequest) Equal(that interface{}) bool {
 	if this.Channel != that1.Channel {
 		return false
 	}
-	return true
-}
+	if this.User != that1.User {
+		return false
+	}
+	return true
+}
+func (this *UnsubscribeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnsubscribeResponse)
+	if !ok {
+		that2, ok := that.(UnsubscribeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	return true
+}
+func (this *UnsubscribeResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnsubscribeResult)
+	if !ok {
+		that2, ok := that.(UnsubscribeResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *DisconnectRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DisconnectRequest)
+	if !ok {
+		that2, ok := that.(DisconnectRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *DisconnectResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DisconnectResponse)
+	if !ok {
+		that2, ok := that.(DisconnectResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	return true
+}
+func (this *DisconnectResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DisconnectResult)
+	if !ok {
+		that2, ok := that.(DisconnectResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *PresenceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PresenceRequest)
+	if !ok {
+		that2, ok := that.(PresenceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Channel != that1.Channel {
+		return false
+	}
+	return true
+}
 func (this *PresenceResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2764,6 +3406,87 @@ func (this *PresenceStatsResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *AggregatedPresenceStatsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AggregatedPresenceStatsRequest)
+	if !ok {
+		that2, ok := that.(AggregatedPresenceStatsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Prefix != that1.Prefix {
+		return false
+	}
+	return true
+}
+func (this *AggregatedPresenceStatsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AggregatedPresenceStatsResponse)
+	if !ok {
+		that2, ok := that.(AggregatedPresenceStatsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	return true
+}
+func (this *AggregatedPresenceStatsResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AggregatedPresenceStatsResult)
+	if !ok {
+		that2, ok := that.(AggregatedPresenceStatsResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumChannels != that1.NumChannels {
+		return false
+	}
+	if this.NumClients != that1.NumClients {
+		return false
+	}
+	if this.NumUsers != that1.NumUsers {
+		return false
+	}
+	return true
+}
 func (this *HistoryRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2786,6 +3509,9 @@ func (this *HistoryRequest) Equal(that interface{}) bool {
 	if this.Channel != that1.Channel {
 		return false
 	}
+	if this.Since != that1.Since {
+		return false
+	}
 	return true
 }
 func (this *HistoryResponse) Equal(that interface{}) bool {
@@ -3193,14 +3919,14 @@ func (this *NodeResult) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Metrics) Equal(that interface{}) bool {
+func (this *EngineHealthRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Metrics)
+	that1, ok := that.(*EngineHealthRequest)
 	if !ok {
-		that2, ok := that.(Metrics)
+		that2, ok := that.(EngineHealthRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3212,21 +3938,218 @@ func (this *Metrics) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Interval != that1.Interval {
+	return true
+}
+func (this *EngineHealthResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EngineHealthResponse)
+	if !ok {
+		that2, ok := that.(EngineHealthResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if len(this.Items) != len(that1.Items) {
+	if !this.Error.Equal(that1.Error) {
 		return false
 	}
-	for i := range this.Items {
-		if this.Items[i] != that1.Items[i] {
-			return false
-		}
+	if !this.Result.Equal(that1.Result) {
+		return false
 	}
 	return true
 }
+func (this *EngineHealthResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
+	that1, ok := that.(*EngineHealthResult)
+	if !ok {
+		that2, ok := that.(EngineHealthResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Operations) != len(that1.Operations) {
+		return false
+	}
+	for i := range this.Operations {
+		if !this.Operations[i].Equal(that1.Operations[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EngineOperationHealth) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EngineOperationHealth)
+	if !ok {
+		that2, ok := that.(EngineOperationHealth)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.P99Ms != that1.P99Ms {
+		return false
+	}
+	if this.ErrorRate != that1.ErrorRate {
+		return false
+	}
+	return true
+}
+func (this *MaintenanceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MaintenanceRequest)
+	if !ok {
+		that2, ok := that.(MaintenanceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if this.BlockSubscribe != that1.BlockSubscribe {
+		return false
+	}
+	return true
+}
+func (this *MaintenanceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MaintenanceResponse)
+	if !ok {
+		that2, ok := that.(MaintenanceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Result.Equal(that1.Result) {
+		return false
+	}
+	return true
+}
+func (this *MaintenanceResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MaintenanceResult)
+	if !ok {
+		that2, ok := that.(MaintenanceResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if this.BlockSubscribe != that1.BlockSubscribe {
+		return false
+	}
+	return true
+}
+func (this *Metrics) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Metrics)
+	if !ok {
+		that2, ok := that.(Metrics)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if len(this.Items) != len(that1.Items) {
+		return false
+	}
+	for i := range this.Items {
+		if this.Items[i] != that1.Items[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
 
@@ -3244,10 +4167,13 @@ type CentrifugoClient interface {
 	Disconnect(ctx context.Context, in *DisconnectRequest, opts ...grpc.CallOption) (*DisconnectResponse, error)
 	Presence(ctx context.Context, in *PresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error)
 	PresenceStats(ctx context.Context, in *PresenceStatsRequest, opts ...grpc.CallOption) (*PresenceStatsResponse, error)
+	AggregatedPresenceStats(ctx context.Context, in *AggregatedPresenceStatsRequest, opts ...grpc.CallOption) (*AggregatedPresenceStatsResponse, error)
 	History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error)
 	HistoryRemove(ctx context.Context, in *HistoryRemoveRequest, opts ...grpc.CallOption) (*HistoryRemoveResponse, error)
 	Channels(ctx context.Context, in *ChannelsRequest, opts ...grpc.CallOption) (*ChannelsResponse, error)
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	EngineHealth(ctx context.Context, in *EngineHealthRequest, opts ...grpc.CallOption) (*EngineHealthResponse, error)
+	Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error)
 	RPC(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (*RPCResponse, error)
 }
 
@@ -3313,6 +4239,15 @@ func (c *centrifugoClient) PresenceStats(ctx context.Context, in *PresenceStatsR
 	return out, nil
 }
 
+func (c *centrifugoClient) AggregatedPresenceStats(ctx context.Context, in *AggregatedPresenceStatsRequest, opts ...grpc.CallOption) (*AggregatedPresenceStatsResponse, error) {
+	out := new(AggregatedPresenceStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.Centrifugo/AggregatedPresenceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *centrifugoClient) History(ctx context.Context, in *HistoryRequest, opts ...grpc.CallOption) (*HistoryResponse, error) {
 	out := new(HistoryResponse)
 	err := c.cc.Invoke(ctx, "/api.Centrifugo/History", in, out, opts...)
@@ -3349,6 +4284,24 @@ func (c *centrifugoClient) Info(ctx context.Context, in *InfoRequest, opts ...gr
 	return out, nil
 }
 
+func (c *centrifugoClient) EngineHealth(ctx context.Context, in *EngineHealthRequest, opts ...grpc.CallOption) (*EngineHealthResponse, error) {
+	out := new(EngineHealthResponse)
+	err := c.cc.Invoke(ctx, "/api.Centrifugo/EngineHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *centrifugoClient) Maintenance(ctx context.Context, in *MaintenanceRequest, opts ...grpc.CallOption) (*MaintenanceResponse, error) {
+	out := new(MaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/api.Centrifugo/Maintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *centrifugoClient) RPC(ctx context.Context, in *RPCRequest, opts ...grpc.CallOption) (*RPCResponse, error) {
 	out := new(RPCResponse)
 	err := c.cc.Invoke(ctx, "/api.Centrifugo/RPC", in, out, opts...)
@@ -3366,10 +4319,13 @@ type CentrifugoServer interface {
 	Disconnect(context.Context, *DisconnectRequest) (*DisconnectResponse, error)
 	Presence(context.Context, *PresenceRequest) (*PresenceResponse, error)
 	PresenceStats(context.Context, *PresenceStatsRequest) (*PresenceStatsResponse, error)
+	AggregatedPresenceStats(context.Context, *AggregatedPresenceStatsRequest) (*AggregatedPresenceStatsResponse, error)
 	History(context.Context, *HistoryRequest) (*HistoryResponse, error)
 	HistoryRemove(context.Context, *HistoryRemoveRequest) (*HistoryRemoveResponse, error)
 	Channels(context.Context, *ChannelsRequest) (*ChannelsResponse, error)
 	Info(context.Context, *InfoRequest) (*InfoResponse, error)
+	EngineHealth(context.Context, *EngineHealthRequest) (*EngineHealthResponse, error)
+	Maintenance(context.Context, *MaintenanceRequest) (*MaintenanceResponse, error)
 	RPC(context.Context, *RPCRequest) (*RPCResponse, error)
 }
 
@@ -3395,6 +4351,9 @@ func (*UnimplementedCentrifugoServer) Presence(ctx context.Context, req *Presenc
 func (*UnimplementedCentrifugoServer) PresenceStats(ctx context.Context, req *PresenceStatsRequest) (*PresenceStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PresenceStats not implemented")
 }
+func (*UnimplementedCentrifugoServer) AggregatedPresenceStats(ctx context.Context, req *AggregatedPresenceStatsRequest) (*AggregatedPresenceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatedPresenceStats not implemented")
+}
 func (*UnimplementedCentrifugoServer) History(ctx context.Context, req *HistoryRequest) (*HistoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
 }
@@ -3407,6 +4366,12 @@ func (*UnimplementedCentrifugoServer) Channels(ctx context.Context, req *Channel
 func (*UnimplementedCentrifugoServer) Info(ctx context.Context, req *InfoRequest) (*InfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
 }
+func (*UnimplementedCentrifugoServer) EngineHealth(ctx context.Context, req *EngineHealthRequest) (*EngineHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EngineHealth not implemented")
+}
+func (*UnimplementedCentrifugoServer) Maintenance(ctx context.Context, req *MaintenanceRequest) (*MaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Maintenance not implemented")
+}
 func (*UnimplementedCentrifugoServer) RPC(ctx context.Context, req *RPCRequest) (*RPCResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RPC not implemented")
 }
@@ -3523,6 +4488,24 @@ func _Centrifugo_PresenceStats_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Centrifugo_AggregatedPresenceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregatedPresenceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentrifugoServer).AggregatedPresenceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Centrifugo/AggregatedPresenceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentrifugoServer).AggregatedPresenceStats(ctx, req.(*AggregatedPresenceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Centrifugo_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HistoryRequest)
 	if err := dec(in); err != nil {
@@ -3595,6 +4578,42 @@ func _Centrifugo_Info_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Centrifugo_EngineHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EngineHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentrifugoServer).EngineHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Centrifugo/EngineHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentrifugoServer).EngineHealth(ctx, req.(*EngineHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Centrifugo_Maintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CentrifugoServer).Maintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Centrifugo/Maintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CentrifugoServer).Maintenance(ctx, req.(*MaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Centrifugo_RPC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RPCRequest)
 	if err := dec(in); err != nil {
@@ -3641,6 +4660,10 @@ var _Centrifugo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PresenceStats",
 			Handler:    _Centrifugo_PresenceStats_Handler,
 		},
+		{
+			MethodName: "AggregatedPresenceStats",
+			Handler:    _Centrifugo_AggregatedPresenceStats_Handler,
+		},
 		{
 			MethodName: "History",
 			Handler:    _Centrifugo_History_Handler,
@@ -3657,6 +4680,14 @@ var _Centrifugo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Info",
 			Handler:    _Centrifugo_Info_Handler,
 		},
+		{
+			MethodName: "EngineHealth",
+			Handler:    _Centrifugo_EngineHealth_Handler,
+		},
+		{
+			MethodName: "Maintenance",
+			Handler:    _Centrifugo_Maintenance_Handler,
+		},
 		{
 			MethodName: "RPC",
 			Handler:    _Centrifugo_RPC_Handler,
@@ -3686,6 +4717,13 @@ func (m *ClientInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.ChanInfo.Size()
 		i -= size
@@ -3923,6 +4961,20 @@ func (m *PublishRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderingKey) > 0 {
+		i -= len(m.OrderingKey)
+		copy(dAtA[i:], m.OrderingKey)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.OrderingKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.Data.Size()
 		i -= size
@@ -4033,6 +5085,13 @@ func (m *BroadcastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.Data.Size()
 		i -= size
@@ -4252,6 +5311,13 @@ func (m *DisconnectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.User) > 0 {
 		i -= len(m.User)
 		copy(dAtA[i:], m.User)
@@ -4568,7 +5634,7 @@ func (m *PresenceStatsResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoryRequest) Marshal() (dAtA []byte, err error) {
+func (m *AggregatedPresenceStatsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4578,27 +5644,27 @@ func (m *HistoryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AggregatedPresenceStatsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AggregatedPresenceStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintApi(dAtA, i, uint64(len(m.Channel)))
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Prefix)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoryResponse) Marshal() (dAtA []byte, err error) {
+func (m *AggregatedPresenceStatsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4608,12 +5674,12 @@ func (m *HistoryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AggregatedPresenceStatsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AggregatedPresenceStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4645,7 +5711,7 @@ func (m *HistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *HistoryResult) Marshal() (dAtA []byte, err error) {
+func (m *AggregatedPresenceStatsResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4655,27 +5721,147 @@ func (m *HistoryResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HistoryResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *AggregatedPresenceStatsResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HistoryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AggregatedPresenceStatsResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Publications) > 0 {
-		for iNdEx := len(m.Publications) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Publications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApi(dAtA, i, uint64(size))
-			}
-			i--
+	if m.NumUsers != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.NumUsers))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumClients != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.NumClients))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumChannels != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.NumChannels))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Since != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Publications) > 0 {
+		for iNdEx := len(m.Publications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Publications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApi(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0xa
 		}
 	}
@@ -5187,7 +6373,7 @@ func (m *NodeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Metrics) Marshal() (dAtA []byte, err error) {
+func (m *EngineHealthRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5197,421 +6383,568 @@ func (m *Metrics) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
+func (m *EngineHealthRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EngineHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for k := range m.Items {
-			v := m.Items[k]
-			baseI := i
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i--
-			dAtA[i] = 0x11
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintApi(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintApi(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Interval != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Interval))))
-		i--
-		dAtA[i] = 0x9
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApi(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedClientInfo(r randyApi, easy bool) *ClientInfo {
-	this := &ClientInfo{}
-	this.User = string(randStringApi(r))
-	this.Client = string(randStringApi(r))
-	v1 := NewPopulatedRaw(r)
-	this.ConnInfo = *v1
-	v2 := NewPopulatedRaw(r)
-	this.ChanInfo = *v2
-	if !easy && r.Intn(10) != 0 {
+func (m *EngineHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedPublication(r randyApi, easy bool) *Publication {
-	this := &Publication{}
-	this.UID = string(randStringApi(r))
-	v3 := NewPopulatedRaw(r)
-	this.Data = *v3
-	if r.Intn(5) != 0 {
-		this.Info = NewPopulatedClientInfo(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *EngineHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedError(r randyApi, easy bool) *Error {
-	this := &Error{}
-	this.Code = uint32(r.Uint32())
-	this.Message = string(randStringApi(r))
-	if !easy && r.Intn(10) != 0 {
+func (m *EngineHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return this
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedCommand(r randyApi, easy bool) *Command {
-	this := &Command{}
-	this.ID = uint32(r.Uint32())
-	this.Method = MethodType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}[r.Intn(11)])
-	v4 := NewPopulatedRaw(r)
-	this.Params = *v4
-	if !easy && r.Intn(10) != 0 {
+func (m *EngineHealthResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedReply(r randyApi, easy bool) *Reply {
-	this := &Reply{}
-	this.ID = uint32(r.Uint32())
-	if r.Intn(5) != 0 {
-		this.Error = NewPopulatedError(r, easy)
-	}
-	v5 := NewPopulatedRaw(r)
-	this.Result = *v5
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *EngineHealthResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedPublishRequest(r randyApi, easy bool) *PublishRequest {
-	this := &PublishRequest{}
-	this.Channel = string(randStringApi(r))
-	v6 := NewPopulatedRaw(r)
-	this.Data = *v6
-	if !easy && r.Intn(10) != 0 {
+func (m *EngineHealthResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedPublishResponse(r randyApi, easy bool) *PublishResponse {
-	this := &PublishResponse{}
-	if r.Intn(5) != 0 {
-		this.Error = NewPopulatedError(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedPublishResult(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *EngineOperationHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedPublishResult(r randyApi, easy bool) *PublishResult {
-	this := &PublishResult{}
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *EngineOperationHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedBroadcastRequest(r randyApi, easy bool) *BroadcastRequest {
-	this := &BroadcastRequest{}
-	v7 := r.Intn(10)
-	this.Channels = make([]string, v7)
-	for i := 0; i < v7; i++ {
-		this.Channels[i] = string(randStringApi(r))
-	}
-	v8 := NewPopulatedRaw(r)
-	this.Data = *v8
-	if !easy && r.Intn(10) != 0 {
+func (m *EngineOperationHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ErrorRate != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorRate))))
+		i--
+		dAtA[i] = 0x21
 	}
-	return this
-}
-
-func NewPopulatedBroadcastResponse(r randyApi, easy bool) *BroadcastResponse {
-	this := &BroadcastResponse{}
-	if r.Intn(5) != 0 {
-		this.Error = NewPopulatedError(r, easy)
+	if m.P99Ms != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.P99Ms))))
+		i--
+		dAtA[i] = 0x19
 	}
-	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedBroadcastResult(r, easy)
+	if m.Count != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(m.Operation) > 0 {
+		i -= len(m.Operation)
+		copy(dAtA[i:], m.Operation)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Operation)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedBroadcastResult(r randyApi, easy bool) *BroadcastResult {
-	this := &BroadcastResult{}
-	if !easy && r.Intn(10) != 0 {
+func (m *MaintenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedUnsubscribeRequest(r randyApi, easy bool) *UnsubscribeRequest {
-	this := &UnsubscribeRequest{}
-	this.Channel = string(randStringApi(r))
-	this.User = string(randStringApi(r))
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MaintenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedUnsubscribeResponse(r randyApi, easy bool) *UnsubscribeResponse {
-	this := &UnsubscribeResponse{}
-	if r.Intn(5) != 0 {
-		this.Error = NewPopulatedError(r, easy)
+func (m *MaintenanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockSubscribe {
+		i--
+		if m.BlockSubscribe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedUnsubscribeResult(r, easy)
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if !easy && r.Intn(10) != 0 {
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedUnsubscribeResult(r randyApi, easy bool) *UnsubscribeResult {
-	this := &UnsubscribeResult{}
-	if !easy && r.Intn(10) != 0 {
+func (m *MaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedDisconnectRequest(r randyApi, easy bool) *DisconnectRequest {
-	this := &DisconnectRequest{}
-	this.User = string(randStringApi(r))
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedDisconnectResponse(r randyApi, easy bool) *DisconnectResponse {
-	this := &DisconnectResponse{}
-	if r.Intn(5) != 0 {
-		this.Error = NewPopulatedError(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedDisconnectResult(r, easy)
+func (m *MaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if !easy && r.Intn(10) != 0 {
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedDisconnectResult(r randyApi, easy bool) *DisconnectResult {
-	this := &DisconnectResult{}
-	if !easy && r.Intn(10) != 0 {
+func (m *MaintenanceResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedPresenceRequest(r randyApi, easy bool) *PresenceRequest {
-	this := &PresenceRequest{}
-	this.Channel = string(randStringApi(r))
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *MaintenanceResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedPresenceResponse(r randyApi, easy bool) *PresenceResponse {
-	this := &PresenceResponse{}
-	if r.Intn(5) != 0 {
-		this.Error = NewPopulatedError(r, easy)
+func (m *MaintenanceResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockSubscribe {
+		i--
+		if m.BlockSubscribe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedPresenceResult(r, easy)
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if !easy && r.Intn(10) != 0 {
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return this
+	return len(dAtA) - i, nil
 }
 
-func NewPopulatedPresenceResult(r randyApi, easy bool) *PresenceResult {
-	this := &PresenceResult{}
-	if r.Intn(5) != 0 {
-		v9 := r.Intn(10)
-		this.Presence = make(map[string]*ClientInfo)
-		for i := 0; i < v9; i++ {
-			this.Presence[randStringApi(r)] = NewPopulatedClientInfo(r, easy)
-		}
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *Metrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedPresenceStatsRequest(r randyApi, easy bool) *PresenceStatsRequest {
-	this := &PresenceStatsRequest{}
-	this.Channel = string(randStringApi(r))
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func NewPopulatedPresenceStatsResponse(r randyApi, easy bool) *PresenceStatsResponse {
-	this := &PresenceStatsResponse{}
-	if r.Intn(5) != 0 {
-		this.Error = NewPopulatedError(r, easy)
+func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for k := range m.Items {
+			v := m.Items[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApi(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApi(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Interval != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Interval))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedClientInfo(r randyApi, easy bool) *ClientInfo {
+	this := &ClientInfo{}
+	this.User = string(randStringApi(r))
+	this.Client = string(randStringApi(r))
+	v1 := NewPopulatedRaw(r)
+	this.ConnInfo = *v1
+	v2 := NewPopulatedRaw(r)
+	this.ChanInfo = *v2
+	this.Node = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
 	}
+	return this
+}
+
+func NewPopulatedPublication(r randyApi, easy bool) *Publication {
+	this := &Publication{}
+	this.UID = string(randStringApi(r))
+	v3 := NewPopulatedRaw(r)
+	this.Data = *v3
 	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedPresenceStatsResult(r, easy)
+		this.Info = NewPopulatedClientInfo(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedPresenceStatsResult(r randyApi, easy bool) *PresenceStatsResult {
-	this := &PresenceStatsResult{}
-	this.NumClients = uint32(r.Uint32())
-	this.NumUsers = uint32(r.Uint32())
+func NewPopulatedError(r randyApi, easy bool) *Error {
+	this := &Error{}
+	this.Code = uint32(r.Uint32())
+	this.Message = string(randStringApi(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedHistoryRequest(r randyApi, easy bool) *HistoryRequest {
-	this := &HistoryRequest{}
+func NewPopulatedCommand(r randyApi, easy bool) *Command {
+	this := &Command{}
+	this.ID = uint32(r.Uint32())
+	this.Method = MethodType([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}[r.Intn(14)])
+	v4 := NewPopulatedRaw(r)
+	this.Params = *v4
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedReply(r randyApi, easy bool) *Reply {
+	this := &Reply{}
+	this.ID = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedError(r, easy)
+	}
+	v5 := NewPopulatedRaw(r)
+	this.Result = *v5
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPublishRequest(r randyApi, easy bool) *PublishRequest {
+	this := &PublishRequest{}
 	this.Channel = string(randStringApi(r))
+	v6 := NewPopulatedRaw(r)
+	this.Data = *v6
+	this.ContentType = string(randStringApi(r))
+	this.OrderingKey = string(randStringApi(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedHistoryResponse(r randyApi, easy bool) *HistoryResponse {
-	this := &HistoryResponse{}
+func NewPopulatedPublishResponse(r randyApi, easy bool) *PublishResponse {
+	this := &PublishResponse{}
 	if r.Intn(5) != 0 {
 		this.Error = NewPopulatedError(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedHistoryResult(r, easy)
+		this.Result = NewPopulatedPublishResult(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedHistoryResult(r randyApi, easy bool) *HistoryResult {
-	this := &HistoryResult{}
+func NewPopulatedPublishResult(r randyApi, easy bool) *PublishResult {
+	this := &PublishResult{}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBroadcastRequest(r randyApi, easy bool) *BroadcastRequest {
+	this := &BroadcastRequest{}
+	v7 := r.Intn(10)
+	this.Channels = make([]string, v7)
+	for i := 0; i < v7; i++ {
+		this.Channels[i] = string(randStringApi(r))
+	}
+	v8 := NewPopulatedRaw(r)
+	this.Data = *v8
+	this.ContentType = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedBroadcastResponse(r randyApi, easy bool) *BroadcastResponse {
+	this := &BroadcastResponse{}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(5)
-		this.Publications = make([]*Publication, v10)
-		for i := 0; i < v10; i++ {
-			this.Publications[i] = NewPopulatedPublication(r, easy)
-		}
+		this.Error = NewPopulatedError(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Result = NewPopulatedBroadcastResult(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedHistoryRemoveRequest(r randyApi, easy bool) *HistoryRemoveRequest {
-	this := &HistoryRemoveRequest{}
+func NewPopulatedBroadcastResult(r randyApi, easy bool) *BroadcastResult {
+	this := &BroadcastResult{}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedUnsubscribeRequest(r randyApi, easy bool) *UnsubscribeRequest {
+	this := &UnsubscribeRequest{}
 	this.Channel = string(randStringApi(r))
+	this.User = string(randStringApi(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedHistoryRemoveResponse(r randyApi, easy bool) *HistoryRemoveResponse {
-	this := &HistoryRemoveResponse{}
+func NewPopulatedUnsubscribeResponse(r randyApi, easy bool) *UnsubscribeResponse {
+	this := &UnsubscribeResponse{}
 	if r.Intn(5) != 0 {
 		this.Error = NewPopulatedError(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedHistoryRemoveResult(r, easy)
+		this.Result = NewPopulatedUnsubscribeResult(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedHistoryRemoveResult(r randyApi, easy bool) *HistoryRemoveResult {
-	this := &HistoryRemoveResult{}
+func NewPopulatedUnsubscribeResult(r randyApi, easy bool) *UnsubscribeResult {
+	this := &UnsubscribeResult{}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedChannelsRequest(r randyApi, easy bool) *ChannelsRequest {
-	this := &ChannelsRequest{}
+func NewPopulatedDisconnectRequest(r randyApi, easy bool) *DisconnectRequest {
+	this := &DisconnectRequest{}
+	this.User = string(randStringApi(r))
+	this.Reason = string(randStringApi(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedChannelsResponse(r randyApi, easy bool) *ChannelsResponse {
-	this := &ChannelsResponse{}
+func NewPopulatedDisconnectResponse(r randyApi, easy bool) *DisconnectResponse {
+	this := &DisconnectResponse{}
 	if r.Intn(5) != 0 {
 		this.Error = NewPopulatedError(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedChannelsResult(r, easy)
+		this.Result = NewPopulatedDisconnectResult(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedChannelsResult(r randyApi, easy bool) *ChannelsResult {
-	this := &ChannelsResult{}
-	v11 := r.Intn(10)
-	this.Channels = make([]string, v11)
-	for i := 0; i < v11; i++ {
-		this.Channels[i] = string(randStringApi(r))
-	}
+func NewPopulatedDisconnectResult(r randyApi, easy bool) *DisconnectResult {
+	this := &DisconnectResult{}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedInfoRequest(r randyApi, easy bool) *InfoRequest {
-	this := &InfoRequest{}
+func NewPopulatedPresenceRequest(r randyApi, easy bool) *PresenceRequest {
+	this := &PresenceRequest{}
+	this.Channel = string(randStringApi(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedInfoResponse(r randyApi, easy bool) *InfoResponse {
-	this := &InfoResponse{}
+func NewPopulatedPresenceResponse(r randyApi, easy bool) *PresenceResponse {
+	this := &PresenceResponse{}
 	if r.Intn(5) != 0 {
 		this.Error = NewPopulatedError(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedInfoResult(r, easy)
+		this.Result = NewPopulatedPresenceResult(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedInfoResult(r randyApi, easy bool) *InfoResult {
-	this := &InfoResult{}
+func NewPopulatedPresenceResult(r randyApi, easy bool) *PresenceResult {
+	this := &PresenceResult{}
 	if r.Intn(5) != 0 {
-		v12 := r.Intn(5)
-		this.Nodes = make([]*NodeResult, v12)
-		for i := 0; i < v12; i++ {
-			this.Nodes[i] = NewPopulatedNodeResult(r, easy)
+		v9 := r.Intn(10)
+		this.Presence = make(map[string]*ClientInfo)
+		for i := 0; i < v9; i++ {
+			this.Presence[randStringApi(r)] = NewPopulatedClientInfo(r, easy)
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -5619,381 +6952,546 @@ func NewPopulatedInfoResult(r randyApi, easy bool) *InfoResult {
 	return this
 }
 
-func NewPopulatedRPCRequest(r randyApi, easy bool) *RPCRequest {
-	this := &RPCRequest{}
-	this.Method = string(randStringApi(r))
-	v13 := NewPopulatedRaw(r)
-	this.Params = *v13
+func NewPopulatedPresenceStatsRequest(r randyApi, easy bool) *PresenceStatsRequest {
+	this := &PresenceStatsRequest{}
+	this.Channel = string(randStringApi(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedRPCResponse(r randyApi, easy bool) *RPCResponse {
-	this := &RPCResponse{}
+func NewPopulatedPresenceStatsResponse(r randyApi, easy bool) *PresenceStatsResponse {
+	this := &PresenceStatsResponse{}
 	if r.Intn(5) != 0 {
 		this.Error = NewPopulatedError(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		this.Result = NewPopulatedRPCResult(r, easy)
+		this.Result = NewPopulatedPresenceStatsResult(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedRPCResult(r randyApi, easy bool) *RPCResult {
-	this := &RPCResult{}
-	v14 := NewPopulatedRaw(r)
-	this.Data = *v14
+func NewPopulatedPresenceStatsResult(r randyApi, easy bool) *PresenceStatsResult {
+	this := &PresenceStatsResult{}
+	this.NumClients = uint32(r.Uint32())
+	this.NumUsers = uint32(r.Uint32())
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedNodeResult(r randyApi, easy bool) *NodeResult {
-	this := &NodeResult{}
-	this.UID = string(randStringApi(r))
-	this.Name = string(randStringApi(r))
-	this.Version = string(randStringApi(r))
-	this.NumClients = uint32(r.Uint32())
-	this.NumUsers = uint32(r.Uint32())
-	this.NumChannels = uint32(r.Uint32())
-	this.Uptime = uint32(r.Uint32())
-	if r.Intn(5) != 0 {
-		this.Metrics = NewPopulatedMetrics(r, easy)
-	}
+func NewPopulatedAggregatedPresenceStatsRequest(r randyApi, easy bool) *AggregatedPresenceStatsRequest {
+	this := &AggregatedPresenceStatsRequest{}
+	this.Prefix = string(randStringApi(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-func NewPopulatedMetrics(r randyApi, easy bool) *Metrics {
-	this := &Metrics{}
-	this.Interval = float64(r.Float64())
-	if r.Intn(2) == 0 {
-		this.Interval *= -1
+func NewPopulatedAggregatedPresenceStatsResponse(r randyApi, easy bool) *AggregatedPresenceStatsResponse {
+	this := &AggregatedPresenceStatsResponse{}
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedError(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v15 := r.Intn(10)
-		this.Items = make(map[string]float64)
-		for i := 0; i < v15; i++ {
-			v16 := randStringApi(r)
-			this.Items[v16] = float64(r.Float64())
-			if r.Intn(2) == 0 {
-				this.Items[v16] *= -1
-			}
-		}
+		this.Result = NewPopulatedAggregatedPresenceStatsResult(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
 
-type randyApi interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+func NewPopulatedAggregatedPresenceStatsResult(r randyApi, easy bool) *AggregatedPresenceStatsResult {
+	this := &AggregatedPresenceStatsResult{}
+	this.NumChannels = uint32(r.Uint32())
+	this.NumClients = uint32(r.Uint32())
+	this.NumUsers = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func randUTF8RuneApi(r randyApi) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func NewPopulatedHistoryRequest(r randyApi, easy bool) *HistoryRequest {
+	this := &HistoryRequest{}
+	this.Channel = string(randStringApi(r))
+	this.Since = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Since *= -1
 	}
-	return rune(ru + 61)
-}
-func randStringApi(r randyApi) string {
-	v17 := r.Intn(100)
-	tmps := make([]rune, v17)
-	for i := 0; i < v17; i++ {
-		tmps[i] = randUTF8RuneApi(r)
+	if !easy && r.Intn(10) != 0 {
 	}
-	return string(tmps)
+	return this
 }
-func randUnrecognizedApi(r randyApi, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldApi(dAtA, r, fieldNumber, wire)
+
+func NewPopulatedHistoryResponse(r randyApi, easy bool) *HistoryResponse {
+	this := &HistoryResponse{}
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedError(r, easy)
 	}
-	return dAtA
+	if r.Intn(5) != 0 {
+		this.Result = NewPopulatedHistoryResult(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func randFieldApi(dAtA []byte, r randyApi, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		v18 := r.Int63()
-		if r.Intn(2) == 0 {
-			v18 *= -1
-		}
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(v18))
-	case 1:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+
+func NewPopulatedHistoryResult(r randyApi, easy bool) *HistoryResult {
+	this := &HistoryResult{}
+	if r.Intn(5) != 0 {
+		v10 := r.Intn(5)
+		this.Publications = make([]*Publication, v10)
+		for i := 0; i < v10; i++ {
+			this.Publications[i] = NewPopulatedPublication(r, easy)
 		}
-	default:
-		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
 	}
-	return dAtA
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
-func encodeVarintPopulateApi(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
-		v >>= 7
+
+func NewPopulatedHistoryRemoveRequest(r randyApi, easy bool) *HistoryRemoveRequest {
+	this := &HistoryRemoveRequest{}
+	this.Channel = string(randStringApi(r))
+	if !easy && r.Intn(10) != 0 {
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+	return this
 }
-func (m *ClientInfo) Size() (n int) {
-	if m == nil {
-		return 0
+
+func NewPopulatedHistoryRemoveResponse(r randyApi, easy bool) *HistoryRemoveResponse {
+	this := &HistoryRemoveResponse{}
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedError(r, easy)
 	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if r.Intn(5) != 0 {
+		this.Result = NewPopulatedHistoryRemoveResult(r, easy)
 	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = m.ConnInfo.Size()
-	n += 1 + l + sovApi(uint64(l))
-	l = m.ChanInfo.Size()
-	n += 1 + l + sovApi(uint64(l))
-	return n
+	return this
 }
 
-func (m *Publication) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.UID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+func NewPopulatedHistoryRemoveResult(r randyApi, easy bool) *HistoryRemoveResult {
+	this := &HistoryRemoveResult{}
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = m.Data.Size()
-	n += 1 + l + sovApi(uint64(l))
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovApi(uint64(l))
+	return this
+}
+
+func NewPopulatedChannelsRequest(r randyApi, easy bool) *ChannelsRequest {
+	this := &ChannelsRequest{}
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *Error) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedChannelsResponse(r randyApi, easy bool) *ChannelsResponse {
+	this := &ChannelsResponse{}
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedError(r, easy)
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovApi(uint64(m.Code))
+	if r.Intn(5) != 0 {
+		this.Result = NewPopulatedChannelsResult(r, easy)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	return n
+	return this
 }
 
-func (m *Command) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedChannelsResult(r randyApi, easy bool) *ChannelsResult {
+	this := &ChannelsResult{}
+	v11 := r.Intn(10)
+	this.Channels = make([]string, v11)
+	for i := 0; i < v11; i++ {
+		this.Channels[i] = string(randStringApi(r))
 	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovApi(uint64(m.ID))
+	if !easy && r.Intn(10) != 0 {
 	}
-	if m.Method != 0 {
-		n += 1 + sovApi(uint64(m.Method))
+	return this
+}
+
+func NewPopulatedInfoRequest(r randyApi, easy bool) *InfoRequest {
+	this := &InfoRequest{}
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovApi(uint64(l))
-	return n
+	return this
 }
 
-func (m *Reply) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedInfoResponse(r randyApi, easy bool) *InfoResponse {
+	this := &InfoResponse{}
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedError(r, easy)
 	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovApi(uint64(m.ID))
+	if r.Intn(5) != 0 {
+		this.Result = NewPopulatedInfoResult(r, easy)
 	}
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = m.Result.Size()
-	n += 1 + l + sovApi(uint64(l))
-	return n
+	return this
 }
 
-func (m *PublishRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedInfoResult(r randyApi, easy bool) *InfoResult {
+	this := &InfoResult{}
+	if r.Intn(5) != 0 {
+		v12 := r.Intn(5)
+		this.Nodes = make([]*NodeResult, v12)
+		for i := 0; i < v12; i++ {
+			this.Nodes[i] = NewPopulatedNodeResult(r, easy)
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if !easy && r.Intn(10) != 0 {
 	}
-	l = m.Data.Size()
-	n += 1 + l + sovApi(uint64(l))
-	return n
+	return this
 }
 
-func (m *PublishResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedRPCRequest(r randyApi, easy bool) *RPCRequest {
+	this := &RPCRequest{}
+	this.Method = string(randStringApi(r))
+	v13 := NewPopulatedRaw(r)
+	this.Params = *v13
+	if !easy && r.Intn(10) != 0 {
 	}
-	var l int
-	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovApi(uint64(l))
+	return this
+}
+
+func NewPopulatedRPCResponse(r randyApi, easy bool) *RPCResponse {
+	this := &RPCResponse{}
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedError(r, easy)
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if r.Intn(5) != 0 {
+		this.Result = NewPopulatedRPCResult(r, easy)
 	}
-	return n
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (m *PublishResult) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedRPCResult(r randyApi, easy bool) *RPCResult {
+	this := &RPCResult{}
+	v14 := NewPopulatedRaw(r)
+	this.Data = *v14
+	if !easy && r.Intn(10) != 0 {
 	}
-	var l int
-	_ = l
-	return n
+	return this
 }
 
-func (m *BroadcastRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func NewPopulatedNodeResult(r randyApi, easy bool) *NodeResult {
+	this := &NodeResult{}
+	this.UID = string(randStringApi(r))
+	this.Name = string(randStringApi(r))
+	this.Version = string(randStringApi(r))
+	this.NumClients = uint32(r.Uint32())
+	this.NumUsers = uint32(r.Uint32())
+	this.NumChannels = uint32(r.Uint32())
+	this.Uptime = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.Metrics = NewPopulatedMetrics(r, easy)
 	}
-	var l int
-	_ = l
-	if len(m.Channels) > 0 {
-		for _, s := range m.Channels {
-			l = len(s)
-			n += 1 + l + sovApi(uint64(l))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEngineHealthRequest(r randyApi, easy bool) *EngineHealthRequest {
+	this := &EngineHealthRequest{}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEngineHealthResponse(r randyApi, easy bool) *EngineHealthResponse {
+	this := &EngineHealthResponse{}
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedError(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Result = NewPopulatedEngineHealthResult(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEngineHealthResult(r randyApi, easy bool) *EngineHealthResult {
+	this := &EngineHealthResult{}
+	if r.Intn(5) != 0 {
+		v15 := r.Intn(5)
+		this.Operations = make([]*EngineOperationHealth, v15)
+		for i := 0; i < v15; i++ {
+			this.Operations[i] = NewPopulatedEngineOperationHealth(r, easy)
 		}
 	}
-	l = m.Data.Size()
-	n += 1 + l + sovApi(uint64(l))
-	return n
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
 }
 
-func (m *BroadcastResponse) Size() (n int) {
+func NewPopulatedEngineOperationHealth(r randyApi, easy bool) *EngineOperationHealth {
+	this := &EngineOperationHealth{}
+	this.Operation = string(randStringApi(r))
+	this.Count = uint32(r.Uint32())
+	this.P99Ms = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.P99Ms *= -1
+	}
+	this.ErrorRate = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.ErrorRate *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMaintenanceRequest(r randyApi, easy bool) *MaintenanceRequest {
+	this := &MaintenanceRequest{}
+	this.Enabled = bool(bool(r.Intn(2) == 0))
+	this.Message = string(randStringApi(r))
+	this.BlockSubscribe = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMaintenanceResponse(r randyApi, easy bool) *MaintenanceResponse {
+	this := &MaintenanceResponse{}
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedError(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Result = NewPopulatedMaintenanceResult(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMaintenanceResult(r randyApi, easy bool) *MaintenanceResult {
+	this := &MaintenanceResult{}
+	this.Enabled = bool(bool(r.Intn(2) == 0))
+	this.Message = string(randStringApi(r))
+	this.BlockSubscribe = bool(bool(r.Intn(2) == 0))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMetrics(r randyApi, easy bool) *Metrics {
+	this := &Metrics{}
+	this.Interval = float64(r.Float64())
+	if r.Intn(2) == 0 {
+		this.Interval *= -1
+	}
+	if r.Intn(5) != 0 {
+		v16 := r.Intn(10)
+		this.Items = make(map[string]float64)
+		for i := 0; i < v16; i++ {
+			v17 := randStringApi(r)
+			this.Items[v17] = float64(r.Float64())
+			if r.Intn(2) == 0 {
+				this.Items[v17] *= -1
+			}
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApi interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApi(r randyApi) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApi(r randyApi) string {
+	v18 := r.Intn(100)
+	tmps := make([]rune, v18)
+	for i := 0; i < v18; i++ {
+		tmps[i] = randUTF8RuneApi(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApi(r randyApi, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApi(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApi(dAtA []byte, r randyApi, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		v19 := r.Int63()
+		if r.Intn(2) == 0 {
+			v19 *= -1
+		}
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(v19))
+	case 1:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApi(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApi(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *ClientInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
+	l = len(m.User)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = m.ConnInfo.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = m.ChanInfo.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.Node)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *BroadcastResult) Size() (n int) {
+func (m *Publication) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovApi(uint64(l))
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
 	return n
 }
 
-func (m *UnsubscribeRequest) Size() (n int) {
+func (m *Error) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
+	if m.Code != 0 {
+		n += 1 + sovApi(uint64(m.Code))
 	}
-	l = len(m.User)
+	l = len(m.Message)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *UnsubscribeResponse) Size() (n int) {
+func (m *Command) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.ID != 0 {
+		n += 1 + sovApi(uint64(m.ID))
 	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if m.Method != 0 {
+		n += 1 + sovApi(uint64(m.Method))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovApi(uint64(l))
 	return n
 }
 
-func (m *UnsubscribeResult) Size() (n int) {
+func (m *Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ID != 0 {
+		n += 1 + sovApi(uint64(m.ID))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = m.Result.Size()
+	n += 1 + l + sovApi(uint64(l))
 	return n
 }
 
-func (m *DisconnectRequest) Size() (n int) {
+func (m *PublishRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.User)
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.OrderingKey)
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
 }
 
-func (m *DisconnectResponse) Size() (n int) {
+func (m *PublishResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6010,7 +7508,7 @@ func (m *DisconnectResponse) Size() (n int) {
 	return n
 }
 
-func (m *DisconnectResult) Size() (n int) {
+func (m *PublishResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6019,14 +7517,147 @@ func (m *DisconnectResult) Size() (n int) {
 	return n
 }
 
-func (m *PresenceRequest) Size() (n int) {
+func (m *BroadcastRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Channel)
-	if l > 0 {
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovApi(uint64(l))
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BroadcastResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *BroadcastResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UnsubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsubscribeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *UnsubscribeResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DisconnectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *DisconnectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *DisconnectResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PresenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
 	return n
@@ -6116,6 +7747,54 @@ func (m *PresenceStatsResult) Size() (n int) {
 	return n
 }
 
+func (m *AggregatedPresenceStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregatedPresenceStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregatedPresenceStatsResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumChannels != 0 {
+		n += 1 + sovApi(uint64(m.NumChannels))
+	}
+	if m.NumClients != 0 {
+		n += 1 + sovApi(uint64(m.NumClients))
+	}
+	if m.NumUsers != 0 {
+		n += 1 + sovApi(uint64(m.NumUsers))
+	}
+	return n
+}
+
 func (m *HistoryRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6126,6 +7805,9 @@ func (m *HistoryRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if m.Since != 0 {
+		n += 1 + sovApi(uint64(m.Since))
+	}
 	return n
 }
 
@@ -6279,116 +7961,1627 @@ func (m *InfoResult) Size() (n int) {
 			n += 1 + l + sovApi(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *RPCRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovApi(uint64(l))
-	return n
-}
-
-func (m *RPCResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovApi(uint64(l))
-	}
-	return n
-}
-
-func (m *RPCResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Data.Size()
-	n += 1 + l + sovApi(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *RPCRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *RPCResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *RPCResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Data.Size()
+	n += 1 + l + sovApi(uint64(l))
+	return n
+}
+
+func (m *NodeResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UID)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.NumClients != 0 {
+		n += 1 + sovApi(uint64(m.NumClients))
+	}
+	if m.NumUsers != 0 {
+		n += 1 + sovApi(uint64(m.NumUsers))
+	}
+	if m.NumChannels != 0 {
+		n += 1 + sovApi(uint64(m.NumChannels))
+	}
+	if m.Uptime != 0 {
+		n += 1 + sovApi(uint64(m.Uptime))
+	}
+	if m.Metrics != nil {
+		l = m.Metrics.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *EngineHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EngineHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *EngineHealthResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EngineOperationHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovApi(uint64(m.Count))
+	}
+	if m.P99Ms != 0 {
+		n += 9
+	}
+	if m.ErrorRate != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *MaintenanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BlockSubscribe {
+		n += 2
+	}
+	return n
+}
+
+func (m *MaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+
+func (m *MaintenanceResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.BlockSubscribe {
+		n += 2
+	}
+	return n
+}
+
+func (m *Metrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 9
+	}
+	if len(m.Items) > 0 {
+		for k, v := range m.Items {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApi(x uint64) (n int) {
+	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClientInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConnInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChanInfo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChanInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Publication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Publication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Publication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &ClientInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Error) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Command) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= MethodType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderingKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderingKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &PublishResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &BroadcastResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *NodeResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.UID)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovApi(uint64(l))
-	}
-	if m.NumClients != 0 {
-		n += 1 + sovApi(uint64(m.NumClients))
-	}
-	if m.NumUsers != 0 {
-		n += 1 + sovApi(uint64(m.NumUsers))
-	}
-	if m.NumChannels != 0 {
-		n += 1 + sovApi(uint64(m.NumChannels))
-	}
-	if m.Uptime != 0 {
-		n += 1 + sovApi(uint64(m.Uptime))
-	}
-	if m.Metrics != nil {
-		l = m.Metrics.Size()
-		n += 1 + l + sovApi(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *Metrics) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Interval != 0 {
-		n += 9
-	}
-	if len(m.Items) > 0 {
-		for k, v := range m.Items {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApi(uint64(len(k))) + 1 + 8
-			n += mapEntrySize + 1 + sovApi(uint64(mapEntrySize))
+func (m *BroadcastResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovApi(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozApi(x uint64) (n int) {
-	return sovApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ClientInfo) Unmarshal(dAtA []byte) error {
+func (m *UnsubscribeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6411,15 +9604,15 @@ func (m *ClientInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsubscribeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6447,11 +9640,11 @@ func (m *ClientInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6479,13 +9672,63 @@ func (m *ClientInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnsubscribeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnsubscribeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnsubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -6495,30 +9738,33 @@ func (m *ClientInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChanInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -6528,22 +9774,25 @@ func (m *ClientInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChanInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Result == nil {
+				m.Result = &UnsubscribeResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6568,7 +9817,7 @@ func (m *ClientInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Publication) Unmarshal(dAtA []byte) error {
+func (m *UnsubscribeResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6591,15 +9840,65 @@ func (m *Publication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Publication: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnsubscribeResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Publication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnsubscribeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisconnectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisconnectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisconnectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6627,46 +9926,13 @@ func (m *Publication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UID = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -6676,27 +9942,23 @@ func (m *Publication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &ClientInfo{}
-			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6719,7 +9981,7 @@ func (m *Publication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Error) Unmarshal(dAtA []byte) error {
+func (m *DisconnectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6742,17 +10004,17 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisconnectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisconnectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.Code = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -6762,16 +10024,33 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -6781,23 +10060,27 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Result == nil {
+				m.Result = &DisconnectResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6820,7 +10103,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(dAtA []byte) error {
+func (m *DisconnectResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6843,55 +10126,67 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: DisconnectResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DisconnectResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Method = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Method |= MethodType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PresenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PresenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PresenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -6901,24 +10196,23 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6941,7 +10235,7 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Reply) Unmarshal(dAtA []byte) error {
+func (m *PresenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6964,32 +10258,13 @@ func (m *Reply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PresenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PresenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			m.ID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ID |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
@@ -7025,11 +10300,11 @@ func (m *Reply) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -7039,21 +10314,24 @@ func (m *Reply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if m.Result == nil {
+				m.Result = &PresenceResult{}
+			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -7079,7 +10357,7 @@ func (m *Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PublishRequest) Unmarshal(dAtA []byte) error {
+func (m *PresenceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7102,17 +10380,17 @@ func (m *PublishRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PresenceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PresenceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -7122,29 +10400,176 @@ func (m *PublishRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			if m.Presence == nil {
+				m.Presence = make(map[string]*ClientInfo)
+			}
+			var mapkey string
+			var mapvalue *ClientInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthApi
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthApi
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ClientInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipApi(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthApi
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Presence[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PresenceStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PresenceStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PresenceStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -7154,24 +10579,23 @@ func (m *PublishRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7194,7 +10618,7 @@ func (m *PublishRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PublishResponse) Unmarshal(dAtA []byte) error {
+func (m *PresenceStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7217,10 +10641,10 @@ func (m *PublishResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PresenceStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PresenceStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7289,7 +10713,7 @@ func (m *PublishResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Result == nil {
-				m.Result = &PublishResult{}
+				m.Result = &PresenceStatsResult{}
 			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7316,7 +10740,7 @@ func (m *PublishResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PublishResult) Unmarshal(dAtA []byte) error {
+func (m *PresenceStatsResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7339,12 +10763,50 @@ func (m *PublishResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: PresenceStatsResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PresenceStatsResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumClients", wireType)
+			}
+			m.NumClients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumClients |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUsers", wireType)
+			}
+			m.NumUsers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUsers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -7366,7 +10828,7 @@ func (m *PublishResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastRequest) Unmarshal(dAtA []byte) error {
+func (m *AggregatedPresenceStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7389,15 +10851,15 @@ func (m *BroadcastRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedPresenceStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedPresenceStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7425,40 +10887,7 @@ func (m *BroadcastRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7481,7 +10910,7 @@ func (m *BroadcastRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastResponse) Unmarshal(dAtA []byte) error {
+func (m *AggregatedPresenceStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7504,10 +10933,10 @@ func (m *BroadcastResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedPresenceStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedPresenceStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7576,7 +11005,7 @@ func (m *BroadcastResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Result == nil {
-				m.Result = &BroadcastResult{}
+				m.Result = &AggregatedPresenceStatsResult{}
 			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7603,7 +11032,7 @@ func (m *BroadcastResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastResult) Unmarshal(dAtA []byte) error {
+func (m *AggregatedPresenceStatsResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7626,12 +11055,69 @@ func (m *BroadcastResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregatedPresenceStatsResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregatedPresenceStatsResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumChannels", wireType)
+			}
+			m.NumChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumChannels |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumClients", wireType)
+			}
+			m.NumClients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumClients |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUsers", wireType)
+			}
+			m.NumUsers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumUsers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -7653,7 +11139,7 @@ func (m *BroadcastResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsubscribeRequest) Unmarshal(dAtA []byte) error {
+func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7676,10 +11162,10 @@ func (m *UnsubscribeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsubscribeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7715,10 +11201,10 @@ func (m *UnsubscribeRequest) Unmarshal(dAtA []byte) error {
 			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
-			var stringLen uint64
+			m.Since = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -7728,24 +11214,11 @@ func (m *UnsubscribeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Since |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.User = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -7767,7 +11240,7 @@ func (m *UnsubscribeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsubscribeResponse) Unmarshal(dAtA []byte) error {
+func (m *HistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7790,10 +11263,10 @@ func (m *UnsubscribeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsubscribeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7862,7 +11335,7 @@ func (m *UnsubscribeResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Result == nil {
-				m.Result = &UnsubscribeResult{}
+				m.Result = &HistoryResult{}
 			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7889,7 +11362,7 @@ func (m *UnsubscribeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnsubscribeResult) Unmarshal(dAtA []byte) error {
+func (m *HistoryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7912,12 +11385,46 @@ func (m *UnsubscribeResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnsubscribeResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnsubscribeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Publications = append(m.Publications, &Publication{})
+			if err := m.Publications[len(m.Publications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -7939,7 +11446,7 @@ func (m *UnsubscribeResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DisconnectRequest) Unmarshal(dAtA []byte) error {
+func (m *HistoryRemoveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7962,15 +11469,15 @@ func (m *DisconnectRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DisconnectRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryRemoveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DisconnectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7998,7 +11505,7 @@ func (m *DisconnectRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8021,7 +11528,7 @@ func (m *DisconnectRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DisconnectResponse) Unmarshal(dAtA []byte) error {
+func (m *HistoryRemoveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8044,10 +11551,10 @@ func (m *DisconnectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DisconnectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryRemoveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DisconnectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8116,7 +11623,7 @@ func (m *DisconnectResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Result == nil {
-				m.Result = &DisconnectResult{}
+				m.Result = &HistoryRemoveResult{}
 			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8143,7 +11650,7 @@ func (m *DisconnectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DisconnectResult) Unmarshal(dAtA []byte) error {
+func (m *HistoryRemoveResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8166,10 +11673,10 @@ func (m *DisconnectResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DisconnectResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: HistoryRemoveResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DisconnectResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HistoryRemoveResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8193,7 +11700,7 @@ func (m *DisconnectResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PresenceRequest) Unmarshal(dAtA []byte) error {
+func (m *ChannelsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8216,44 +11723,12 @@ func (m *PresenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PresenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PresenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -8275,7 +11750,7 @@ func (m *PresenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PresenceResponse) Unmarshal(dAtA []byte) error {
+func (m *ChannelsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8298,10 +11773,10 @@ func (m *PresenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PresenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PresenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8370,7 +11845,7 @@ func (m *PresenceResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Result == nil {
-				m.Result = &PresenceResult{}
+				m.Result = &ChannelsResult{}
 			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8397,7 +11872,7 @@ func (m *PresenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PresenceResult) Unmarshal(dAtA []byte) error {
+func (m *ChannelsResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8420,17 +11895,17 @@ func (m *PresenceResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PresenceResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelsResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PresenceResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelsResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Presence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -8440,120 +11915,23 @@ func (m *PresenceResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Presence == nil {
-				m.Presence = make(map[string]*ClientInfo)
-			}
-			var mapkey string
-			var mapvalue *ClientInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthApi
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthApi
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthApi
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ClientInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipApi(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthApi
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Presence[mapkey] = mapvalue
+			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8576,7 +11954,7 @@ func (m *PresenceResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PresenceStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *InfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8599,44 +11977,12 @@ func (m *PresenceStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PresenceStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PresenceStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -8658,7 +12004,7 @@ func (m *PresenceStatsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PresenceStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *InfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8681,10 +12027,10 @@ func (m *PresenceStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PresenceStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PresenceStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8753,7 +12099,7 @@ func (m *PresenceStatsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Result == nil {
-				m.Result = &PresenceStatsResult{}
+				m.Result = &InfoResult{}
 			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8780,7 +12126,7 @@ func (m *PresenceStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PresenceStatsResult) Unmarshal(dAtA []byte) error {
+func (m *InfoResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8803,17 +12149,17 @@ func (m *PresenceStatsResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PresenceStatsResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfoResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PresenceStatsResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfoResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumClients", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			m.NumClients = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -8823,30 +12169,26 @@ func (m *PresenceStatsResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumClients |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumUsers", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthApi
 			}
-			m.NumUsers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumUsers |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeResult{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -8868,7 +12210,7 @@ func (m *PresenceStatsResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *RPCRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8891,15 +12233,15 @@ func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RPCRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RPCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8927,7 +12269,40 @@ func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8950,7 +12325,7 @@ func (m *HistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *RPCResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8973,10 +12348,10 @@ func (m *HistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RPCResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RPCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9045,7 +12420,7 @@ func (m *HistoryResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Result == nil {
-				m.Result = &HistoryResult{}
+				m.Result = &RPCResult{}
 			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9072,7 +12447,7 @@ func (m *HistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoryResult) Unmarshal(dAtA []byte) error {
+func (m *RPCResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9095,17 +12470,17 @@ func (m *HistoryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: RPCResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RPCResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Publications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -9115,23 +12490,22 @@ func (m *HistoryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Publications = append(m.Publications, &Publication{})
-			if err := m.Publications[len(m.Publications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9156,7 +12530,7 @@ func (m *HistoryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoryRemoveRequest) Unmarshal(dAtA []byte) error {
+func (m *NodeResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9179,15 +12553,15 @@ func (m *HistoryRemoveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryRemoveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryRemoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9215,63 +12589,45 @@ func (m *HistoryRemoveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.UID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HistoryRemoveResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryRemoveResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryRemoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -9281,33 +12637,48 @@ func (m *HistoryRemoveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumClients", wireType)
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NumClients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumClients |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUsers", wireType)
 			}
-			var msglen int
+			m.NumUsers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -9317,78 +12688,85 @@ func (m *HistoryRemoveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NumUsers |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumChannels", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
+			m.NumChannels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumChannels |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			if m.Result == nil {
-				m.Result = &HistoryRemoveResult{}
+			m.Uptime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uptime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HistoryRemoveResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Metrics == nil {
+				m.Metrics = &Metrics{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HistoryRemoveResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoryRemoveResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -9410,7 +12788,7 @@ func (m *HistoryRemoveResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelsRequest) Unmarshal(dAtA []byte) error {
+func (m *EngineHealthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9433,10 +12811,10 @@ func (m *ChannelsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EngineHealthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EngineHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9460,7 +12838,7 @@ func (m *ChannelsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelsResponse) Unmarshal(dAtA []byte) error {
+func (m *EngineHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9483,10 +12861,10 @@ func (m *ChannelsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EngineHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EngineHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9555,7 +12933,7 @@ func (m *ChannelsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Result == nil {
-				m.Result = &ChannelsResult{}
+				m.Result = &EngineHealthResult{}
 			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9582,7 +12960,7 @@ func (m *ChannelsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelsResult) Unmarshal(dAtA []byte) error {
+func (m *EngineHealthResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9605,17 +12983,17 @@ func (m *ChannelsResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelsResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: EngineHealthResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelsResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EngineHealthResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -9625,74 +13003,26 @@ func (m *ChannelsResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channels = append(m.Channels, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
+			m.Operations = append(m.Operations, &EngineOperationHealth{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -9714,7 +13044,7 @@ func (m *InfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoResponse) Unmarshal(dAtA []byte) error {
+func (m *EngineOperationHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9737,17 +13067,17 @@ func (m *InfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EngineOperationHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EngineOperationHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -9757,33 +13087,29 @@ func (m *InfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
-			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -9793,28 +13119,33 @@ func (m *InfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P99Ms", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &InfoResult{}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.P99Ms = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRate", wireType)
 			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ErrorRate = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -9836,7 +13167,7 @@ func (m *InfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoResult) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9859,17 +13190,17 @@ func (m *InfoResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -9879,79 +13210,15 @@ func (m *InfoResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nodes = append(m.Nodes, &NodeResult{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RPCRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RPCRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RPCRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9979,13 +13246,13 @@ func (m *RPCRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSubscribe", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -9995,25 +13262,12 @@ func (m *RPCRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.BlockSubscribe = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -10035,7 +13289,7 @@ func (m *RPCRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RPCResponse) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10058,10 +13312,10 @@ func (m *RPCResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RPCResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RPCResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10130,7 +13384,7 @@ func (m *RPCResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Result == nil {
-				m.Result = &RPCResult{}
+				m.Result = &MaintenanceResult{}
 			}
 			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10157,90 +13411,7 @@ func (m *RPCResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RPCResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RPCResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RPCResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeResult) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10263,17 +13434,17 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -10283,27 +13454,15 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10331,121 +13490,13 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumClients", wireType)
-			}
-			m.NumClients = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumClients |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumUsers", wireType)
-			}
-			m.NumUsers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumUsers |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumChannels", wireType)
-			}
-			m.NumChannels = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumChannels |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
-			}
-			m.Uptime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Uptime |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSubscribe", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -10455,28 +13506,12 @@ func (m *NodeResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metrics == nil {
-				m.Metrics = &Metrics{}
-			}
-			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.BlockSubscribe = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -2,8 +2,14 @@ package api
 
 import (
 	"context"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/centrifugal/centrifugo/internal/enginestats"
+	"github.com/centrifugal/centrifugo/internal/maintenance"
+	"github.com/centrifugal/centrifugo/internal/presence"
 	"github.com/centrifugal/centrifugo/internal/rule"
 
 	"github.com/centrifugal/centrifuge"
@@ -43,6 +49,34 @@ func TestPublishAPI(t *testing.T) {
 	require.Equal(t, ErrorNamespaceNotFound, resp.Error)
 }
 
+func TestPublishAPIDataValidation(t *testing.T) {
+	node := nodeWithMemoryEngine()
+
+	api := NewExecutor(node, rule.NewContainer(rule.DefaultConfig), "test")
+	api.SetConfig(ExecutorConfig{PublishDataValidation: DataValidationJSON})
+	resp := api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte(`{"text": "hello"}`)})
+	require.Nil(t, resp.Error)
+
+	resp = api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte(`{"text": `)})
+	require.Equal(t, ErrorBadRequest, resp.Error)
+
+	broadcastResp := api.Broadcast(context.Background(), &BroadcastRequest{Channels: []string{"test"}, Data: []byte("test")})
+	require.Equal(t, ErrorBadRequest, broadcastResp.Error)
+
+	api.SetConfig(ExecutorConfig{PublishDataValidation: DataValidationUTF8})
+	resp = api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte("test")})
+	require.Nil(t, resp.Error)
+	resp = api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte{0xff, 0xfe}})
+	require.Equal(t, ErrorBadRequest, resp.Error)
+
+	api.SetConfig(ExecutorConfig{PublishDataValidation: DataValidationNone})
+	resp = api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte{0xff, 0xfe}})
+	require.Nil(t, resp.Error)
+
+	require.NoError(t, ExecutorConfig{PublishDataValidation: DataValidationJSON}.Validate())
+	require.Error(t, ExecutorConfig{PublishDataValidation: "xml"}.Validate())
+}
+
 func TestBroadcastAPI(t *testing.T) {
 	node := nodeWithMemoryEngine()
 	ruleConfig := rule.DefaultConfig
@@ -65,6 +99,122 @@ func TestBroadcastAPI(t *testing.T) {
 	require.Equal(t, ErrorNamespaceNotFound, resp.Error)
 }
 
+func TestBroadcastAPIOrdering(t *testing.T) {
+	for _, workers := range []int{1, 2, 8} {
+		t.Run(strconv.Itoa(workers), func(t *testing.T) {
+			node := nodeWithMemoryEngine()
+			ruleConfig := rule.DefaultConfig
+			ruleConfig.HistorySize = 100
+			ruleConfig.HistoryLifetime = 60
+			ruleContainer := rule.NewContainer(ruleConfig)
+			api := NewExecutor(node, ruleContainer, "test")
+			api.SetConfig(ExecutorConfig{BroadcastWorkers: workers})
+
+			channels := make([]string, 20)
+			for i := range channels {
+				channels[i] = "test" + strconv.Itoa(i)
+			}
+			for i := 0; i < 50; i++ {
+				resp := api.Broadcast(context.Background(), &BroadcastRequest{
+					Channels: channels,
+					Data:     []byte(strconv.Itoa(i)),
+				})
+				require.Nil(t, resp.Error)
+			}
+			for _, ch := range channels {
+				resp := api.History(context.Background(), &HistoryRequest{Channel: ch})
+				require.Nil(t, resp.Error)
+				require.Len(t, resp.Result.Publications, 50)
+				for i, pub := range resp.Result.Publications {
+					require.Equal(t, Raw(strconv.Itoa(i)), pub.Data)
+				}
+			}
+		})
+	}
+}
+
+// concurrencyBroker tracks max number of concurrent Publish calls.
+type concurrencyBroker struct {
+	centrifuge.Broker
+	mu      sync.Mutex
+	current int
+	max     int
+}
+
+func (b *concurrencyBroker) Publish(ch string, data []byte, opts centrifuge.PublishOptions) (centrifuge.StreamPosition, error) {
+	b.mu.Lock()
+	b.current++
+	if b.current > b.max {
+		b.max = b.current
+	}
+	b.mu.Unlock()
+	time.Sleep(5 * time.Millisecond)
+	b.mu.Lock()
+	b.current--
+	b.mu.Unlock()
+	return b.Broker.Publish(ch, data, opts)
+}
+
+func TestBroadcastAPIWorkers(t *testing.T) {
+	for _, workers := range []int{1, 3} {
+		t.Run(strconv.Itoa(workers), func(t *testing.T) {
+			node, err := centrifuge.New(centrifuge.DefaultConfig)
+			require.NoError(t, err)
+			engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+			require.NoError(t, err)
+			node.SetEngine(engine)
+			broker := &concurrencyBroker{Broker: engine}
+			node.SetBroker(broker)
+			require.NoError(t, node.Run())
+			defer func() { _ = node.Shutdown(context.Background()) }()
+
+			api := NewExecutor(node, rule.NewContainer(rule.DefaultConfig), "test")
+			api.SetConfig(ExecutorConfig{BroadcastWorkers: workers})
+
+			channels := make([]string, 12)
+			for i := range channels {
+				channels[i] = "test" + strconv.Itoa(i)
+			}
+			resp := api.Broadcast(context.Background(), &BroadcastRequest{Channels: channels, Data: []byte(`{}`)})
+			require.Nil(t, resp.Error)
+			require.Equal(t, workers, broker.max)
+		})
+	}
+	require.Error(t, ExecutorConfig{BroadcastWorkers: -1}.Validate())
+}
+
+func TestExecutorConfigValidateOverloadReconnectDelay(t *testing.T) {
+	c := ExecutorConfig{OverloadReconnectDelayMin: time.Second, OverloadReconnectDelayMax: 30 * time.Second}
+	require.NoError(t, c.Validate())
+	c.OverloadReconnectDelayMax = 0
+	require.NoError(t, c.Validate())
+	c.OverloadReconnectDelayMax = 500 * time.Millisecond
+	require.Error(t, c.Validate())
+}
+
+func BenchmarkBroadcastAPI(b *testing.B) {
+	for _, workers := range []int{1, 4, 16, 64} {
+		b.Run(strconv.Itoa(workers), func(b *testing.B) {
+			node := nodeWithMemoryEngine()
+			api := NewExecutor(node, rule.NewContainer(rule.DefaultConfig), "test")
+			api.SetConfig(ExecutorConfig{BroadcastWorkers: workers})
+			channels := make([]string, 100)
+			for i := range channels {
+				channels[i] = "test" + strconv.Itoa(i)
+			}
+			cmd := &BroadcastRequest{Channels: channels, Data: []byte(`{}`)}
+			b.ResetTimer()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				resp := api.Broadcast(context.Background(), cmd)
+				if resp.Error != nil {
+					b.Fatal(resp.Error)
+				}
+			}
+		})
+	}
+}
+
 func TestHistoryAPI(t *testing.T) {
 	node := nodeWithMemoryEngine()
 	ruleConfig := rule.DefaultConfig
@@ -85,6 +235,59 @@ func TestHistoryAPI(t *testing.T) {
 	require.Nil(t, resp.Error)
 }
 
+type offsetPublicationTimes struct {
+	node  *centrifuge.Node
+	since map[int64]uint64
+}
+
+func (t offsetPublicationTimes) Since(ch string, since time.Time) ([]*centrifuge.Publication, bool, error) {
+	offset, ok := t.since[since.UnixNano()/int64(time.Millisecond)]
+	if !ok {
+		return nil, false, nil
+	}
+	history, err := t.node.History(ch, centrifuge.WithLimit(centrifuge.NoLimit))
+	if err != nil {
+		return nil, false, err
+	}
+	var res []*centrifuge.Publication
+	for _, pub := range history.Publications {
+		if pub.Offset >= offset {
+			res = append(res, pub)
+		}
+	}
+	return res, true, nil
+}
+
+func TestHistoryAPISince(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.HistorySize = 10
+	ruleConfig.HistoryLifetime = 60
+	ruleContainer := rule.NewContainer(ruleConfig)
+
+	api := NewExecutor(node, ruleContainer, "test")
+	for _, data := range []string{`{"n":1}`, `{"n":2}`, `{"n":3}`} {
+		resp := api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: Raw(data)})
+		require.Nil(t, resp.Error)
+	}
+	resp := api.History(context.Background(), &HistoryRequest{Channel: "test", Since: 1000})
+	require.Equal(t, ErrorNotAvailable, resp.Error)
+
+	api.SetPublicationTimes(offsetPublicationTimes{node: node, since: map[int64]uint64{1000: 2}})
+	resp = api.History(context.Background(), &HistoryRequest{Channel: "test", Since: -1})
+	require.Equal(t, ErrorBadRequest, resp.Error)
+	resp = api.History(context.Background(), &HistoryRequest{Channel: "test", Since: 1000})
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.Result.Publications, 2)
+	require.Equal(t, Raw(`{"n":2}`), resp.Result.Publications[0].Data)
+	resp = api.History(context.Background(), &HistoryRequest{Channel: "test"})
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.Result.Publications, 3)
+	// Publication times not known.
+	resp = api.History(context.Background(), &HistoryRequest{Channel: "test", Since: 2000})
+	require.Equal(t, ErrorNotAvailable, resp.Error)
+}
+
 func TestHistoryRemoveAPI(t *testing.T) {
 	node := nodeWithMemoryEngine()
 	ruleConfig := rule.DefaultConfig
@@ -125,6 +328,50 @@ func TestPresenceAPI(t *testing.T) {
 	require.Nil(t, resp.Error)
 }
 
+func TestPresenceAPINodeName(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	// Two nodes share the same presence storage like with Redis engine.
+	engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Presence = true
+	ruleContainer := rule.NewContainer(ruleConfig)
+	tracker1 := presence.NewNodeTracker(engine, "node1", ruleContainer)
+	tracker2 := presence.NewNodeTracker(engine, "node2", ruleContainer)
+	node.SetPresenceManager(tracker1)
+	api := NewExecutor(node, ruleContainer, "test")
+
+	addPresence := func() {
+		require.NoError(t, tracker1.AddPresence("test", "client1", &centrifuge.ClientInfo{UserID: "1", ClientID: "client1"}, time.Minute))
+		require.NoError(t, tracker2.AddPresence("test", "client2", &centrifuge.ClientInfo{UserID: "2", ClientID: "client2"}, time.Minute))
+	}
+
+	addPresence()
+	resp := api.Presence(context.Background(), &PresenceRequest{Channel: "test"})
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.Result.Presence, 2)
+	require.Equal(t, "", resp.Result.Presence["client1"].Node)
+	nodePresence, err := engine.Presence(presence.NodeChannel("test"))
+	require.NoError(t, err)
+	require.Len(t, nodePresence, 0)
+
+	// Option turned on with reload applies on next presence update.
+	ruleConfig.PresenceNodeName = true
+	require.NoError(t, ruleContainer.Reload(ruleConfig))
+	addPresence()
+	resp = api.Presence(context.Background(), &PresenceRequest{Channel: "test"})
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.Result.Presence, 2)
+	require.Equal(t, "node1", resp.Result.Presence["client1"].Node)
+	require.Equal(t, "node2", resp.Result.Presence["client2"].Node)
+
+	require.NoError(t, tracker2.RemovePresence("test", "client2"))
+	nodePresence, err = engine.Presence(presence.NodeChannel("test"))
+	require.NoError(t, err)
+	require.Len(t, nodePresence, 1)
+}
+
 func TestPresenceStatsAPI(t *testing.T) {
 	node := nodeWithMemoryEngine()
 	ruleConfig := rule.DefaultConfig
@@ -144,6 +391,63 @@ func TestPresenceStatsAPI(t *testing.T) {
 	require.Nil(t, resp.Error)
 }
 
+func TestAggregatedPresenceStatsAPI(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{
+		Name:           "building",
+		ChannelOptions: rule.ChannelOptions{Presence: true},
+	}}
+	ruleContainer := rule.NewContainer(ruleConfig)
+
+	var user string
+	var channels []string
+	node.OnConnecting(func(_ context.Context, _ centrifuge.ConnectEvent) (centrifuge.ConnectReply, error) {
+		subs := map[string]centrifuge.SubscribeOptions{}
+		for _, ch := range channels {
+			subs[ch] = centrifuge.SubscribeOptions{Presence: true}
+		}
+		return centrifuge.ConnectReply{
+			Credentials:   &centrifuge.Credentials{UserID: user},
+			Subscriptions: subs,
+		}, nil
+	})
+	for _, c := range []struct {
+		user     string
+		channels []string
+	}{
+		{"1", []string{"building:floor:1", "building:floor:2"}},
+		{"2", []string{"building:floor:1"}},
+		{"3", []string{"building:floor"}},
+		{"4", []string{"building:floors:1", "building:roof"}},
+	} {
+		user, channels = c.user, c.channels
+		connectTestClient(t, node)
+	}
+
+	api := NewExecutor(node, ruleContainer, "test")
+	resp := api.AggregatedPresenceStats(context.Background(), &AggregatedPresenceStatsRequest{})
+	require.Equal(t, ErrorBadRequest, resp.Error)
+
+	resp = api.AggregatedPresenceStats(context.Background(), &AggregatedPresenceStatsRequest{Prefix: "building:floor"})
+	require.Nil(t, resp.Error)
+	require.Equal(t, uint32(3), resp.Result.NumChannels)
+	require.Equal(t, uint32(4), resp.Result.NumClients)
+	// User 1 counted in both channels.
+	require.Equal(t, uint32(4), resp.Result.NumUsers)
+
+	resp = api.AggregatedPresenceStats(context.Background(), &AggregatedPresenceStatsRequest{Prefix: "building:floor:"})
+	require.Nil(t, resp.Error)
+	require.Equal(t, uint32(2), resp.Result.NumChannels)
+	require.Equal(t, uint32(3), resp.Result.NumClients)
+	require.Equal(t, uint32(3), resp.Result.NumUsers)
+
+	resp = api.AggregatedPresenceStats(context.Background(), &AggregatedPresenceStatsRequest{Prefix: "building"})
+	require.Nil(t, resp.Error)
+	require.Equal(t, uint32(5), resp.Result.NumChannels)
+	require.Equal(t, uint32(6), resp.Result.NumUsers)
+}
+
 func TestDisconnectAPI(t *testing.T) {
 	node := nodeWithMemoryEngine()
 	ruleConfig := rule.DefaultConfig
@@ -192,3 +496,54 @@ func TestInfoAPI(t *testing.T) {
 	resp := api.Info(context.Background(), &InfoRequest{})
 	require.Nil(t, resp.Error)
 }
+
+func TestEngineHealthAPI(t *testing.T) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	engineStats := enginestats.New()
+	node.SetBroker(enginestats.NewBroker(engine, engineStats))
+	node.SetPresenceManager(enginestats.NewPresenceManager(engine, engineStats))
+	require.NoError(t, node.Run())
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	api := NewExecutor(node, rule.NewContainer(rule.DefaultConfig), "test")
+	resp := api.EngineHealth(context.Background(), &EngineHealthRequest{})
+	require.Equal(t, ErrorNotAvailable, resp.Error)
+
+	api.SetEngineStats(engineStats)
+	resp = api.EngineHealth(context.Background(), &EngineHealthRequest{})
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.Result.Operations, 0)
+
+	publishResp := api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: Raw(`{}`)})
+	require.Nil(t, publishResp.Error)
+	resp = api.EngineHealth(context.Background(), &EngineHealthRequest{})
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.Result.Operations, 1)
+	require.Equal(t, enginestats.OperationPublish, resp.Result.Operations[0].Operation)
+	require.Equal(t, uint32(1), resp.Result.Operations[0].Count)
+	require.True(t, resp.Result.Operations[0].P99Ms >= 0)
+	require.Zero(t, resp.Result.Operations[0].ErrorRate)
+}
+
+func TestMaintenanceAPI(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	api := NewExecutor(node, rule.NewContainer(rule.DefaultConfig), "test")
+	resp := api.Maintenance(context.Background(), &MaintenanceRequest{Enabled: true})
+	require.Equal(t, ErrorNotAvailable, resp.Error)
+
+	mode := maintenance.New(maintenance.State{})
+	api.SetMaintenance(mode)
+	resp = api.Maintenance(context.Background(), &MaintenanceRequest{Enabled: true, Message: "back soon", BlockSubscribe: true})
+	require.Nil(t, resp.Error)
+	require.Equal(t, &MaintenanceResult{Enabled: true, Message: "back soon", BlockSubscribe: true}, resp.Result)
+	require.Equal(t, maintenance.State{Enabled: true, Message: "back soon", BlockSubscribe: true}, mode.State())
+
+	resp = api.Maintenance(context.Background(), &MaintenanceRequest{})
+	require.Nil(t, resp.Error)
+	require.Equal(t, maintenance.State{}, mode.State())
+}
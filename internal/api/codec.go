@@ -0,0 +1,60 @@
+package api
+
+import (
+	"errors"
+)
+
+// Codec processes publication data of specific content type when data
+// published over server API. Codec called once per publish or broadcast
+// command before data published into engine – it's not called on delivery,
+// so all subscribers of channel receive the same data.
+type Codec interface {
+	// Validate returns error if data can not be decoded.
+	Validate(data []byte) error
+	// Encode returns data which will be published into channel. Codec which
+	// does not need re-encoding should return data as is.
+	Encode(data []byte) ([]byte, error)
+}
+
+// ContentTypeJSON is a content type of JSON publication data.
+const ContentTypeJSON = "application/json"
+
+var errInvalidData = errors.New("invalid data")
+
+// validationCodec checks data with publish data validation and passes it
+// as is.
+type validationCodec DataValidation
+
+func (c validationCodec) Validate(data []byte) error {
+	if !validData(DataValidation(c), data) {
+		return errInvalidData
+	}
+	return nil
+}
+
+func (validationCodec) Encode(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+// RegisterCodec registers Codec for content type of publication data. Data
+// of content types without registered Codec passed through as is. Codecs
+// must be registered before Executor used.
+func (h Executor) RegisterCodec(contentType string, codec Codec) {
+	h.codecs[contentType] = codec
+}
+
+// encodeData validates and re-encodes data using Codec registered for
+// content type.
+func (h *Executor) encodeData(contentType string, data []byte) ([]byte, error) {
+	if contentType == "" {
+		return data, nil
+	}
+	codec, ok := h.codecs[contentType]
+	if !ok {
+		return data, nil
+	}
+	if err := codec.Validate(data); err != nil {
+		return nil, err
+	}
+	return codec.Encode(data)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testCodec accepts only data with prefix and strips it on encode.
+type testCodec struct {
+	calls int
+}
+
+func (c *testCodec) Validate(data []byte) error {
+	c.calls++
+	if !bytes.HasPrefix(data, []byte("test:")) {
+		return errors.New("no prefix")
+	}
+	return nil
+}
+
+func (c *testCodec) Encode(data []byte) ([]byte, error) {
+	return bytes.TrimPrefix(data, []byte("test:")), nil
+}
+
+func TestPublishAPICodec(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.HistorySize = 10
+	ruleConfig.HistoryLifetime = 60
+	api := NewExecutor(node, rule.NewContainer(ruleConfig), "test")
+
+	codec := &testCodec{}
+	api.RegisterCodec("application/x-test", codec)
+
+	resp := api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte("test:1"), ContentType: "application/x-test"})
+	require.Nil(t, resp.Error)
+	require.Equal(t, 1, codec.calls)
+
+	resp = api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte("2"), ContentType: "application/x-test"})
+	require.Equal(t, ErrorBadRequest, resp.Error)
+	require.Equal(t, 2, codec.calls)
+
+	// Other content types not processed by codec.
+	resp = api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte("test:3"), ContentType: "application/x-unknown"})
+	require.Nil(t, resp.Error)
+	resp = api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte("test:4")})
+	require.Nil(t, resp.Error)
+	require.Equal(t, 2, codec.calls)
+
+	broadcastResp := api.Broadcast(context.Background(), &BroadcastRequest{Channels: []string{"test"}, Data: []byte("test:5"), ContentType: "application/x-test"})
+	require.Nil(t, broadcastResp.Error)
+	require.Equal(t, 3, codec.calls)
+
+	historyResp := api.History(context.Background(), &HistoryRequest{Channel: "test"})
+	require.Nil(t, historyResp.Error)
+	var data []string
+	for _, pub := range historyResp.Result.Publications {
+		data = append(data, string(pub.Data))
+	}
+	require.Equal(t, []string{"1", "test:3", "test:4", "5"}, data)
+}
+
+func TestPublishAPIJSONCodec(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	api := NewExecutor(node, rule.NewContainer(rule.DefaultConfig), "test")
+
+	resp := api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte(`{"a":1}`), ContentType: ContentTypeJSON})
+	require.Nil(t, resp.Error)
+	resp = api.Publish(context.Background(), &PublishRequest{Channel: "test", Data: []byte(`{"a":`), ContentType: ContentTypeJSON})
+	require.Equal(t, ErrorBadRequest, resp.Error)
+}
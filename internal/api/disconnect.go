@@ -0,0 +1,98 @@
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// Disconnect reasons which can be passed to disconnect API method. Each reason
+// results into a distinct close code sent to client so client SDKs can react
+// accordingly.
+const (
+	// DisconnectReasonShutdown – normal shutdown, client should reconnect.
+	DisconnectReasonShutdown = "shutdown"
+	// DisconnectReasonPolicyViolation – client violated some policy,
+	// client should not reconnect.
+	DisconnectReasonPolicyViolation = "policy_violation"
+	// DisconnectReasonGoingAway – server is going away, client should
+	// reconnect (possibly to another node).
+	DisconnectReasonGoingAway = "going_away"
+	// DisconnectReasonOverload – server is overloaded, client should
+	// reconnect later.
+	DisconnectReasonOverload = "overload"
+)
+
+// Centrifugo disconnects with codes outside of range used by centrifuge
+// library internally (3000-3499) and range reserved for custom application
+// disconnects (4000-4999).
+var (
+	// DisconnectPolicyViolation sent when client disconnected due to policy violation.
+	DisconnectPolicyViolation = &centrifuge.Disconnect{
+		Code:      3500,
+		Reason:    "policy violation",
+		Reconnect: false,
+	}
+	// DisconnectGoingAway sent when server is going away.
+	DisconnectGoingAway = &centrifuge.Disconnect{
+		Code:      3501,
+		Reason:    "going away",
+		Reconnect: true,
+	}
+	// DisconnectOverload sent when server is overloaded.
+	DisconnectOverload = &centrifuge.Disconnect{
+		Code:      3502,
+		Reason:    "overload",
+		Reconnect: true,
+	}
+)
+
+var disconnectReasons = map[string]*centrifuge.Disconnect{
+	DisconnectReasonShutdown:        centrifuge.DisconnectShutdown,
+	DisconnectReasonPolicyViolation: DisconnectPolicyViolation,
+	DisconnectReasonGoingAway:       DisconnectGoingAway,
+	DisconnectReasonOverload:        DisconnectOverload,
+}
+
+// ReconnectAdvice returns reconnect delay range advised to clients disconnected
+// due to overload. Range widens proportionally to node load calculated as
+// a ratio of current connections to node capacity. Zero capacity means node
+// is fully loaded.
+func ReconnectAdvice(numClients int, capacity int, minDelay, maxDelay time.Duration) (time.Duration, time.Duration) {
+	load := 1.0
+	if capacity > 0 {
+		load = float64(numClients) / float64(capacity)
+		if load > 1 {
+			load = 1
+		}
+	}
+	return minDelay, minDelay + time.Duration(float64(maxDelay-minDelay)*load)
+}
+
+// overloadDisconnect returns DisconnectOverload with reconnect advice in reason
+// in format "overload, reconnect in <min>-<max>ms". When advice not configured
+// DisconnectOverload returned as is.
+func overloadDisconnect(numClients int, capacity int, minDelay, maxDelay time.Duration) *centrifuge.Disconnect {
+	if maxDelay <= 0 {
+		return DisconnectOverload
+	}
+	low, high := ReconnectAdvice(numClients, capacity, minDelay, maxDelay)
+	return &centrifuge.Disconnect{
+		Code: DisconnectOverload.Code,
+		Reason: DisconnectOverload.Reason + ", reconnect in " +
+			strconv.FormatInt(int64(low/time.Millisecond), 10) + "-" +
+			strconv.FormatInt(int64(high/time.Millisecond), 10) + "ms",
+		Reconnect: DisconnectOverload.Reconnect,
+	}
+}
+
+// DisconnectForReason returns Disconnect for structured disconnect reason. Empty
+// reason results into default force disconnect without reconnect advice.
+func DisconnectForReason(reason string) (*centrifuge.Disconnect, bool) {
+	if reason == "" {
+		return centrifuge.DisconnectForceNoReconnect, true
+	}
+	d, ok := disconnectReasons[reason]
+	return d, ok
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/centrifugal/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+type testTransport struct {
+	mu         sync.Mutex
+	closeCh    chan struct{}
+	disconnect *centrifuge.Disconnect
+}
+
+func newTestTransport() *testTransport {
+	return &testTransport{closeCh: make(chan struct{})}
+}
+
+func (t *testTransport) Write(_ []byte) error { return nil }
+func (t *testTransport) Name() string         { return "test_transport" }
+func (t *testTransport) Protocol() centrifuge.ProtocolType {
+	return centrifuge.ProtocolTypeJSON
+}
+func (t *testTransport) Encoding() centrifuge.EncodingType {
+	return centrifuge.EncodingTypeJSON
+}
+func (t *testTransport) Close(disconnect *centrifuge.Disconnect) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.disconnect != nil {
+		return nil
+	}
+	t.disconnect = disconnect
+	close(t.closeCh)
+	return nil
+}
+
+func connectTestClient(t *testing.T, node *centrifuge.Node) *testTransport {
+	transport := newTestTransport()
+	client, _, err := centrifuge.NewClient(context.Background(), node, transport)
+	require.NoError(t, err)
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 1})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+	return transport
+}
+
+func TestDisconnectForReason(t *testing.T) {
+	testCases := []struct {
+		reason    string
+		code      uint32
+		reconnect bool
+	}{
+		{"", 3012, false},
+		{DisconnectReasonShutdown, 3001, true},
+		{DisconnectReasonPolicyViolation, 3500, false},
+		{DisconnectReasonGoingAway, 3501, true},
+		{DisconnectReasonOverload, 3502, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.reason, func(t *testing.T) {
+			d, ok := DisconnectForReason(tc.reason)
+			require.True(t, ok)
+			require.Equal(t, tc.code, d.Code)
+			require.Equal(t, tc.reconnect, d.Reconnect)
+		})
+	}
+	_, ok := DisconnectForReason("unknown")
+	require.False(t, ok)
+}
+
+func TestDisconnectAPICloseCode(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	node.OnConnecting(func(_ context.Context, _ centrifuge.ConnectEvent) (centrifuge.ConnectReply, error) {
+		return centrifuge.ConnectReply{Credentials: &centrifuge.Credentials{UserID: "test"}}, nil
+	})
+	ruleContainer := rule.NewContainer(rule.DefaultConfig)
+	api := NewExecutor(node, ruleContainer, "test")
+
+	resp := api.Disconnect(context.Background(), &DisconnectRequest{User: "test", Reason: "unknown"})
+	require.Equal(t, ErrorBadRequest, resp.Error)
+
+	for reason, code := range map[string]uint32{
+		DisconnectReasonShutdown:        3001,
+		DisconnectReasonPolicyViolation: 3500,
+		DisconnectReasonGoingAway:       3501,
+		DisconnectReasonOverload:        3502,
+	} {
+		transport := connectTestClient(t, node)
+		resp := api.Disconnect(context.Background(), &DisconnectRequest{User: "test", Reason: reason})
+		require.Nil(t, resp.Error)
+		select {
+		case <-transport.closeCh:
+			require.Equal(t, code, transport.disconnect.Code)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for disconnect")
+		}
+	}
+}
+
+func TestReconnectAdvice(t *testing.T) {
+	minDelay, maxDelay := time.Second, 31*time.Second
+	var prevHigh time.Duration
+	for _, numClients := range []int{0, 100, 500, 900} {
+		low, high := ReconnectAdvice(numClients, 1000, minDelay, maxDelay)
+		require.Equal(t, minDelay, low)
+		require.True(t, high >= prevHigh)
+		if numClients > 0 {
+			require.True(t, high > prevHigh)
+		}
+		prevHigh = high
+	}
+	_, high := ReconnectAdvice(0, 1000, minDelay, maxDelay)
+	require.Equal(t, minDelay, high)
+	_, high = ReconnectAdvice(500, 1000, minDelay, maxDelay)
+	require.Equal(t, 16*time.Second, high)
+	_, high = ReconnectAdvice(5000, 1000, minDelay, maxDelay)
+	require.Equal(t, maxDelay, high)
+	_, high = ReconnectAdvice(1, 0, minDelay, maxDelay)
+	require.Equal(t, maxDelay, high)
+}
+
+func TestOverloadDisconnect(t *testing.T) {
+	require.Equal(t, DisconnectOverload, overloadDisconnect(10, 100, 0, 0))
+	d := overloadDisconnect(50, 100, time.Second, 11*time.Second)
+	require.Equal(t, DisconnectOverload.Code, d.Code)
+	require.True(t, d.Reconnect)
+	require.Equal(t, "overload, reconnect in 1000-6000ms", d.Reason)
+	require.True(t, len(d.CloseText()) < 123)
+}
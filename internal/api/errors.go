@@ -21,6 +21,11 @@ var (
 		Code:    102,
 		Message: "namespace not found",
 	}
+	// ErrorPermissionDenied means that access to resource not allowed.
+	ErrorPermissionDenied = &Error{
+		Code:    103,
+		Message: "permission denied",
+	}
 	// ErrorMethodNotFound means that method sent in command does not exist.
 	ErrorMethodNotFound = &Error{
 		Code:    104,
@@ -101,11 +101,26 @@ func (s *grpcAPIService) PresenceStats(ctx context.Context, req *PresenceStatsRe
 	return s.api.PresenceStats(ctx, req), nil
 }
 
+// AggregatedPresenceStats information for channels matching prefix.
+func (s *grpcAPIService) AggregatedPresenceStats(ctx context.Context, req *AggregatedPresenceStatsRequest) (*AggregatedPresenceStatsResponse, error) {
+	return s.api.AggregatedPresenceStats(ctx, req), nil
+}
+
 // Info returns information about Centrifugo state.
 func (s *grpcAPIService) Info(ctx context.Context, req *InfoRequest) (*InfoResponse, error) {
 	return s.api.Info(ctx, req), nil
 }
 
+// EngineHealth returns latency and error rate of recent engine operations.
+func (s *grpcAPIService) EngineHealth(ctx context.Context, req *EngineHealthRequest) (*EngineHealthResponse, error) {
+	return s.api.EngineHealth(ctx, req), nil
+}
+
+// Maintenance toggles maintenance mode of node.
+func (s *grpcAPIService) Maintenance(ctx context.Context, req *MaintenanceRequest) (*MaintenanceResponse, error) {
+	return s.api.Maintenance(ctx, req), nil
+}
+
 // RPC can return custom data.
 func (s *grpcAPIService) RPC(ctx context.Context, req *RPCRequest) (*RPCResponse, error) {
 	return s.api.RPC(ctx, req), nil
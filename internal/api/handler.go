@@ -2,30 +2,83 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/centrifugal/centrifuge"
 )
 
 // Config configures APIHandler.
-type Config struct{}
+type Config struct {
+	// ReadOnly restricts handler to API methods which do not change server
+	// state: presence, presence_stats, aggregated_presence_stats, history,
+	// channels and info.
+	ReadOnly bool
+	// ResponseEnvelope sets shape of JSON API replies. By default replies
+	// wrapped with ResponseEnvelopeWrapped.
+	ResponseEnvelope ResponseEnvelope
+	// AcceptForm allows application/x-www-form-urlencoded requests with
+	// single command set by method, params (JSON) and optional id form fields.
+	// Replies to such requests encoded as JSON.
+	AcceptForm bool
+}
+
+// ResponseEnvelope describes shape of JSON API reply.
+type ResponseEnvelope string
+
+const (
+	// ResponseEnvelopeWrapped puts command result under result key:
+	// {"id": 1, "result": {...}}.
+	ResponseEnvelopeWrapped ResponseEnvelope = "wrapped"
+	// ResponseEnvelopeFlat puts command result fields on top level of reply
+	// next to id: {"id": 1, ...}. Error message sent under error key next to
+	// error code: {"id": 1, "error": "...", "code": 102}.
+	ResponseEnvelopeFlat ResponseEnvelope = "flat"
+)
+
+// Validate validates Config.
+func (c Config) Validate() error {
+	switch c.ResponseEnvelope {
+	case "", ResponseEnvelopeWrapped, ResponseEnvelopeFlat:
+		return nil
+	default:
+		return fmt.Errorf("unknown API response envelope: %s", c.ResponseEnvelope)
+	}
+}
+
+func isReadOnlyMethod(method MethodType) bool {
+	switch method {
+	case MethodTypePresence, MethodTypePresenceStats, MethodTypeAggregatedPresenceStats,
+		MethodTypeHistory, MethodTypeChannels, MethodTypeInfo, MethodTypeEngineHealth:
+		return true
+	default:
+		return false
+	}
+}
 
 // Handler is responsible for processing API commands over HTTP.
 type Handler struct {
 	node   *centrifuge.Node
 	config Config
 	api    *Executor
+	// laneWorkers limits number of ordering lanes processed concurrently.
+	laneWorkers int
 }
 
 // NewHandler creates new APIHandler.
 func NewHandler(n *centrifuge.Node, apiExecutor *Executor, c Config) *Handler {
 	return &Handler{
-		node:   n,
-		config: c,
-		api:    apiExecutor,
+		node:        n,
+		config:      c,
+		api:         apiExecutor,
+		laneWorkers: runtime.GOMAXPROCS(0),
 	}
 }
 
@@ -56,44 +109,225 @@ func (s *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	var enc Encoding
 
 	contentType := r.Header.Get("Content-Type")
+	isForm := s.config.AcceptForm && strings.HasPrefix(strings.ToLower(contentType), "application/x-www-form-urlencoded")
 	if strings.HasPrefix(strings.ToLower(contentType), "application/octet-stream") {
 		enc = EncodingProtobuf
 	} else {
 		enc = EncodingJSON
 	}
+	if isForm {
+		contentType = "application/json"
+	}
+
+	var encoder ReplyEncoder
+	if enc == EncodingJSON && s.config.ResponseEnvelope == ResponseEnvelopeFlat {
+		encoder = NewFlatJSONReplyEncoder()
+	} else {
+		encoder = GetReplyEncoder(enc)
+		defer PutReplyEncoder(enc, encoder)
+	}
 
-	encoder := GetReplyEncoder(enc)
-	defer PutReplyEncoder(enc, encoder)
+	var decoder CommandDecoder
+	if isForm {
+		command, err := decodeFormCommand(data)
+		if err != nil {
+			s.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error decoding API form data", map[string]interface{}{"error": err.Error()}))
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		decoder = &formCommandDecoder{command: command}
+	} else {
+		commandDecoder := GetCommandDecoder(enc, data)
+		defer PutCommandDecoder(enc, commandDecoder)
+		decoder = commandDecoder
+	}
 
-	decoder := GetCommandDecoder(enc, data)
-	defer PutCommandDecoder(enc, decoder)
+	if status := s.handleAPICommands(r.Context(), enc, decoder, encoder); status != http.StatusOK {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	resp := encoder.Finish()
+	w.Header().Set("Content-Type", contentType)
+	_, _ = w.Write(resp)
+}
 
+// decodeFormCommand decodes command from form-encoded body with method,
+// params and optional id fields.
+func decodeFormCommand(data []byte) (*Command, error) {
+	values, err := url.ParseQuery(string(data))
+	if err != nil {
+		return nil, err
+	}
+	method := values.Get("method")
+	if method == "" {
+		return nil, fmt.Errorf("method required")
+	}
+	command := &Command{}
+	if err := command.Method.UnmarshalJSON([]byte(method)); err != nil {
+		return nil, fmt.Errorf("unknown method: %s", method)
+	}
+	if id := values.Get("id"); id != "" {
+		val, err := strconv.ParseUint(id, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id: %s", id)
+		}
+		command.ID = uint32(val)
+	}
+	command.Params = Raw(values.Get("params"))
+	return command, nil
+}
+
+// formCommandDecoder returns single command decoded from form data.
+type formCommandDecoder struct {
+	command *Command
+}
+
+func (d *formCommandDecoder) Reset(_ []byte) error {
+	return nil
+}
+
+func (d *formCommandDecoder) Decode() (*Command, error) {
+	if d.command == nil {
+		return nil, io.EOF
+	}
+	command := d.command
+	d.command = nil
+	return command, nil
+}
+
+// orderingLane identifies commands which must be processed in order.
+type orderingLane struct {
+	channel string
+	key     string
+}
+
+// commandOrderingLane returns ordering lane of publish command with ordering
+// key set.
+func commandOrderingLane(decoder Decoder, command *Command) (orderingLane, bool) {
+	if command.Method != MethodTypePublish {
+		return orderingLane{}, false
+	}
+	cmd, err := decoder.DecodePublish(command.Params)
+	if err != nil || cmd.OrderingKey == "" {
+		return orderingLane{}, false
+	}
+	return orderingLane{channel: cmd.Channel, key: cmd.OrderingKey}, true
+}
+
+// orderingLanes collects consecutive publish commands with ordering key.
+// Returns indexes of commands for every lane.
+type orderingLanes struct {
+	commands  []*Command
+	lanes     [][]int
+	laneIndex map[orderingLane]int
+}
+
+func (l *orderingLanes) add(lane orderingLane, command *Command) {
+	if l.laneIndex == nil {
+		l.laneIndex = map[orderingLane]int{}
+	}
+	idx, ok := l.laneIndex[lane]
+	if !ok {
+		idx = len(l.lanes)
+		l.laneIndex[lane] = idx
+		l.lanes = append(l.lanes, nil)
+	}
+	l.lanes[idx] = append(l.lanes[idx], len(l.commands))
+	l.commands = append(l.commands, command)
+}
+
+func (l *orderingLanes) reset() {
+	l.commands = nil
+	l.lanes = nil
+	l.laneIndex = nil
+}
+
+// handleAPICommands processes commands in order as they decoded and encodes
+// replies in commands order. Only consecutive publish commands with ordering
+// key collected: they are processed in order inside ordering lanes while
+// different lanes processed concurrently by at most laneWorkers goroutines.
+// Any other command processed after all collected ones, so it's never
+// reordered with them even in the same channel. Returns HTTP status code.
+func (s *Handler) handleAPICommands(ctx context.Context, enc Encoding, decoder CommandDecoder, encoder ReplyEncoder) int {
+	paramsDecoder := GetDecoder(enc)
+	defer PutDecoder(enc, paramsDecoder)
+
+	var lanes orderingLanes
 	for {
 		command, err := decoder.Decode()
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
+		if err != nil && err != io.EOF {
 			s.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error decoding API data", map[string]interface{}{"error": err.Error()}))
-			http.Error(w, "Bad Request", http.StatusBadRequest)
-			return
+			return http.StatusBadRequest
+		}
+		if err == nil {
+			if lane, ok := commandOrderingLane(paramsDecoder, command); ok {
+				lanes.add(lane, command)
+				continue
+			}
 		}
-		rep, err := s.handleAPICommand(r.Context(), enc, command)
+		replies, err := s.handleOrderingLanes(ctx, enc, &lanes)
 		if err != nil {
 			s.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error handling API command", map[string]interface{}{"error": err.Error()}))
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-			return
+			return http.StatusInternalServerError
+		}
+		lanes.reset()
+		if command != nil {
+			rep, err := s.handleAPICommand(ctx, enc, command)
+			if err != nil {
+				s.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error handling API command", map[string]interface{}{"error": err.Error()}))
+				return http.StatusInternalServerError
+			}
+			replies = append(replies, rep)
+		}
+		for _, rep := range replies {
+			if err := encoder.Encode(rep); err != nil {
+				s.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error encoding API reply", map[string]interface{}{"error": err.Error()}))
+				return http.StatusInternalServerError
+			}
 		}
-		err = encoder.Encode(rep)
+		if command == nil {
+			return http.StatusOK
+		}
+	}
+}
+
+// handleOrderingLanes processes collected commands in order inside ordering
+// lanes while different lanes processed concurrently by at most laneWorkers
+// goroutines. Replies returned in commands order.
+func (s *Handler) handleOrderingLanes(ctx context.Context, enc Encoding, lanes *orderingLanes) ([]*Reply, error) {
+	if len(lanes.commands) == 0 {
+		return nil, nil
+	}
+	replies := make([]*Reply, len(lanes.commands))
+	errs := make([]error, len(lanes.lanes))
+
+	sem := make(chan struct{}, s.laneWorkers)
+
+	var wg sync.WaitGroup
+	for i, lane := range lanes.lanes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, lane []int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			for _, idx := range lane {
+				rep, err := s.handleAPICommand(ctx, enc, lanes.commands[idx])
+				if err != nil {
+					errs[i] = err
+					return
+				}
+				replies[idx] = rep
+			}
+		}(i, lane)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
 		if err != nil {
-			s.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error encoding API reply", map[string]interface{}{"error": err.Error()}))
-			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
-			return
+			return nil, err
 		}
 	}
-	resp := encoder.Finish()
-	w.Header().Set("Content-Type", contentType)
-	_, _ = w.Write(resp)
+	return replies, nil
 }
 
 func (s *Handler) handleAPICommand(ctx context.Context, enc Encoding, cmd *Command) (*Reply, error) {
@@ -105,6 +339,11 @@ func (s *Handler) handleAPICommand(ctx context.Context, enc Encoding, cmd *Comma
 		ID: cmd.ID,
 	}
 
+	if s.config.ReadOnly && !isReadOnlyMethod(method) {
+		rep.Error = ErrorPermissionDenied
+		return rep, nil
+	}
+
 	var replyRes Raw
 
 	decoder := GetDecoder(enc)
@@ -222,6 +461,24 @@ func (s *Handler) handleAPICommand(ctx context.Context, enc Encoding, cmd *Comma
 				}
 			}
 		}
+	case MethodTypeAggregatedPresenceStats:
+		cmd, err := decoder.DecodeAggregatedPresenceStats(params)
+		if err != nil {
+			s.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error decoding aggregated presence stats params", map[string]interface{}{"error": err.Error()}))
+			rep.Error = ErrorBadRequest
+			return rep, nil
+		}
+		resp := s.api.AggregatedPresenceStats(ctx, cmd)
+		if resp.Error != nil {
+			rep.Error = resp.Error
+		} else {
+			if resp.Result != nil {
+				replyRes, err = encoder.EncodeAggregatedPresenceStats(resp.Result)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
 	case MethodTypeHistory:
 		cmd, err := decoder.DecodeHistory(params)
 		if err != nil {
@@ -284,6 +541,37 @@ func (s *Handler) handleAPICommand(ctx context.Context, enc Encoding, cmd *Comma
 				}
 			}
 		}
+	case MethodTypeEngineHealth:
+		resp := s.api.EngineHealth(ctx, &EngineHealthRequest{})
+		if resp.Error != nil {
+			rep.Error = resp.Error
+		} else {
+			if resp.Result != nil {
+				var err error
+				replyRes, err = encoder.EncodeEngineHealth(resp.Result)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	case MethodTypeMaintenance:
+		cmd, err := decoder.DecodeMaintenance(params)
+		if err != nil {
+			s.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error decoding maintenance params", map[string]interface{}{"error": err.Error()}))
+			rep.Error = ErrorBadRequest
+			return rep, nil
+		}
+		resp := s.api.Maintenance(ctx, cmd)
+		if resp.Error != nil {
+			rep.Error = resp.Error
+		} else {
+			if resp.Result != nil {
+				replyRes, err = encoder.EncodeMaintenance(resp.Result)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
 	case MethodTypeRPC:
 		cmd, err := decoder.DecodeRPC(params)
 		if err != nil {
@@ -2,13 +2,19 @@ package api
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
+	"github.com/centrifugal/centrifugo/internal/maintenance"
 	"github.com/centrifugal/centrifugo/internal/rule"
 
+	"github.com/centrifugal/centrifuge"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,3 +58,283 @@ func TestAPIHandler(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, resp.StatusCode, http.StatusBadRequest)
 }
+
+func TestOrderingLanes(t *testing.T) {
+	decoder := GetDecoder(EncodingJSON)
+	defer PutDecoder(EncodingJSON, decoder)
+
+	var lanes orderingLanes
+	for _, params := range []string{
+		`{"channel": "test", "data": {}, "ordering_key": "a"}`,
+		`{"channel": "test", "data": {}, "ordering_key": "b"}`,
+		`{"channel": "test", "data": {}, "ordering_key": "a"}`,
+		`{"channel": "other", "data": {}, "ordering_key": "a"}`,
+	} {
+		command := &Command{Method: MethodTypePublish, Params: Raw(params)}
+		lane, ok := commandOrderingLane(decoder, command)
+		require.True(t, ok)
+		lanes.add(lane, command)
+	}
+	require.Equal(t, [][]int{{0, 2}, {1}, {3}}, lanes.lanes)
+
+	_, ok := commandOrderingLane(decoder, &Command{Method: MethodTypePublish, Params: Raw(`{"channel": "test", "data": {}}`)})
+	require.False(t, ok)
+	_, ok = commandOrderingLane(decoder, &Command{Method: MethodTypeInfo})
+	require.False(t, ok)
+}
+
+func TestAPIHandlerOrderingKeyMixed(t *testing.T) {
+	n := nodeWithMemoryEngine()
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.HistorySize = 100
+	ruleConfig.HistoryLifetime = 60
+	apiExecutor := NewExecutor(n, rule.NewContainer(ruleConfig), "test")
+	handler := NewHandler(n, apiExecutor, Config{})
+	handler.laneWorkers = 4
+
+	var body bytes.Buffer
+	for i := 0; i < 20; i++ {
+		for _, key := range []string{"a", "b"} {
+			body.WriteString(fmt.Sprintf(`{"method": "publish", "params": {"channel": "test", "data": {"key": "%s", "seq": %d}, "ordering_key": "%s"}}`+"\n", key, i, key))
+		}
+		body.WriteString(fmt.Sprintf(`{"id": %d, "method": "publish", "params": {"channel": "test", "data": {"seq": %d}}}`+"\n", i+1, i))
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api", &body))
+	require.Equal(t, http.StatusOK, rec.Code)
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	require.Len(t, lines, 60)
+	require.Equal(t, `{"id":1}`, lines[2])
+
+	resp := apiExecutor.History(context.Background(), &HistoryRequest{Channel: "test"})
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.Result.Publications, 60)
+	// Command without ordering key is never reordered with keyed commands.
+	for i := 0; i < 20; i++ {
+		var seen []string
+		for _, pub := range resp.Result.Publications[i*3 : i*3+3] {
+			var data struct {
+				Key string `json:"key"`
+				Seq int    `json:"seq"`
+			}
+			require.NoError(t, json.Unmarshal(pub.Data, &data))
+			require.Equal(t, i, data.Seq)
+			seen = append(seen, data.Key)
+		}
+		require.Equal(t, "", seen[2])
+		require.ElementsMatch(t, []string{"a", "b", ""}, seen)
+	}
+}
+
+func TestAPIHandlerOrderingKey(t *testing.T) {
+	n := nodeWithMemoryEngine()
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.HistorySize = 100
+	ruleConfig.HistoryLifetime = 60
+	apiExecutor := NewExecutor(n, rule.NewContainer(ruleConfig), "test")
+	handler := NewHandler(n, apiExecutor, Config{})
+
+	var body bytes.Buffer
+	for i := 0; i < 30; i++ {
+		for _, key := range []string{"a", "b", "c"} {
+			body.WriteString(fmt.Sprintf(`{"id": %d, "method": "publish", "params": {"channel": "test", "data": {"key": "%s", "seq": %d}, "ordering_key": "%s"}}`+"\n", i, key, i, key))
+		}
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api", &body))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Replies keep commands order.
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	require.Len(t, lines, 90)
+	require.Equal(t, `{"id":29}`, lines[89])
+
+	resp := apiExecutor.History(context.Background(), &HistoryRequest{Channel: "test"})
+	require.Nil(t, resp.Error)
+	require.Len(t, resp.Result.Publications, 90)
+	lastSeq := map[string]int{}
+	for _, pub := range resp.Result.Publications {
+		var data struct {
+			Key string `json:"key"`
+			Seq int    `json:"seq"`
+		}
+		require.NoError(t, json.Unmarshal(pub.Data, &data))
+		if seq, ok := lastSeq[data.Key]; ok {
+			require.Equal(t, seq+1, data.Seq, "messages with the same key must be ordered")
+		} else {
+			require.Equal(t, 0, data.Seq)
+		}
+		lastSeq[data.Key] = data.Seq
+	}
+}
+
+func TestAPIHandlerOrderingLanesConcurrency(t *testing.T) {
+	testCases := []struct {
+		name        string
+		keys        []string
+		laneWorkers int
+		concurrency int
+	}{
+		{"same_key", []string{"a"}, 4, 1},
+		{"different_keys", []string{"a", "b", "c"}, 4, 3},
+		{"bounded", []string{"a", "b", "c", "d", "e"}, 2, 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			n, err := centrifuge.New(centrifuge.DefaultConfig)
+			require.NoError(t, err)
+			engine, err := centrifuge.NewMemoryEngine(n, centrifuge.MemoryEngineConfig{})
+			require.NoError(t, err)
+			n.SetEngine(engine)
+			broker := &concurrencyBroker{Broker: engine}
+			n.SetBroker(broker)
+			require.NoError(t, n.Run())
+			defer func() { _ = n.Shutdown(context.Background()) }()
+
+			apiExecutor := NewExecutor(n, rule.NewContainer(rule.DefaultConfig), "test")
+			handler := NewHandler(n, apiExecutor, Config{})
+			handler.laneWorkers = tc.laneWorkers
+
+			var body bytes.Buffer
+			for i := 0; i < 4; i++ {
+				for _, key := range tc.keys {
+					body.WriteString(fmt.Sprintf(`{"method": "publish", "params": {"channel": "test", "data": {}, "ordering_key": "%s"}}`+"\n", key))
+				}
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api", &body))
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, tc.concurrency, broker.max)
+		})
+	}
+}
+
+func TestAPIHandlerResponseEnvelope(t *testing.T) {
+	n := nodeWithMemoryEngine()
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.HistorySize = 10
+	ruleConfig.HistoryLifetime = 60
+	apiExecutor := NewExecutor(n, rule.NewContainer(ruleConfig), "test")
+
+	body := `{"id": 1, "method": "publish", "params": {"channel": "test", "data": {}}}` + "\n" +
+		`{"id": 2, "method": "publish", "params": {"channel": "unknown:test", "data": {}}}` + "\n" +
+		`{"id": 3, "method": "history", "params": {"channel": "unknown:test"}}`
+
+	testCases := []struct {
+		envelope ResponseEnvelope
+		expected []string
+	}{
+		{"", []string{
+			`{"id":1}`,
+			`{"id":2,"error":{"code":102,"message":"namespace not found"}}`,
+			`{"id":3,"error":{"code":102,"message":"namespace not found"}}`,
+		}},
+		{ResponseEnvelopeWrapped, []string{
+			`{"id":1}`,
+			`{"id":2,"error":{"code":102,"message":"namespace not found"}}`,
+			`{"id":3,"error":{"code":102,"message":"namespace not found"}}`,
+		}},
+		{ResponseEnvelopeFlat, []string{
+			`{"id":1}`,
+			`{"id":2,"error":"namespace not found","code":102}`,
+			`{"id":3,"error":"namespace not found","code":102}`,
+		}},
+	}
+	for _, tc := range testCases {
+		require.NoError(t, Config{ResponseEnvelope: tc.envelope}.Validate())
+		handler := NewHandler(n, apiExecutor, Config{ResponseEnvelope: tc.envelope})
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api", strings.NewReader(body)))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, strings.Join(tc.expected, "\n")+"\n", rec.Body.String())
+	}
+	require.Error(t, Config{ResponseEnvelope: "unknown"}.Validate())
+}
+
+func TestAPIHandlerResponseEnvelopeFlatResult(t *testing.T) {
+	n := nodeWithMemoryEngine()
+	apiExecutor := NewExecutor(n, rule.NewContainer(rule.DefaultConfig), "test")
+	handler := NewHandler(n, apiExecutor, Config{ResponseEnvelope: ResponseEnvelopeFlat})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api", strings.NewReader(`{"id": 1, "method": "channels"}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, `{"id":1,"channels":[]}`+"\n", rec.Body.String())
+}
+
+func TestAPIHandlerForm(t *testing.T) {
+	n := nodeWithMemoryEngine()
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.HistorySize = 10
+	ruleConfig.HistoryLifetime = 60
+	apiExecutor := NewExecutor(n, rule.NewContainer(ruleConfig), "test")
+
+	post := func(handler *Handler, contentType string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api", strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Form body not accepted by default.
+	form := url.Values{}
+	form.Set("id", "1")
+	form.Set("method", "publish")
+	form.Set("params", `{"channel": "test", "data": {"text": "hello"}}`)
+	rec := post(NewHandler(n, apiExecutor, Config{}), "application/x-www-form-urlencoded", form.Encode())
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	handler := NewHandler(n, apiExecutor, Config{AcceptForm: true})
+	jsonRec := post(handler, "application/json", `{"id": 1, "method": "publish", "params": {"channel": "test", "data": {"text": "hello"}}}`)
+	require.Equal(t, http.StatusOK, jsonRec.Code)
+	formRec := post(handler, "application/x-www-form-urlencoded", form.Encode())
+	require.Equal(t, http.StatusOK, formRec.Code)
+	require.Equal(t, "application/json", formRec.Header().Get("Content-Type"))
+	require.Equal(t, jsonRec.Body.String(), formRec.Body.String())
+
+	history, err := n.History("test", centrifuge.WithLimit(centrifuge.NoLimit))
+	require.NoError(t, err)
+	require.Len(t, history.Publications, 2)
+	require.Equal(t, history.Publications[0].Data, history.Publications[1].Data)
+
+	// Errors in form reported like for JSON.
+	form.Set("params", `{"channel": "", "data": {}}`)
+	formRec = post(handler, "application/x-www-form-urlencoded", form.Encode())
+	require.Equal(t, http.StatusOK, formRec.Code)
+	require.Equal(t, `{"id":1,"error":{"code":107,"message":"bad request"}}`+"\n", formRec.Body.String())
+	for _, body := range []string{"params=%7B%7D", "method=unknown", "method=publish&id=x"} {
+		require.Equal(t, http.StatusBadRequest, post(handler, "application/x-www-form-urlencoded", body).Code)
+	}
+}
+
+func TestAPIHandlerMaintenance(t *testing.T) {
+	n := nodeWithMemoryEngine()
+	apiExecutor := NewExecutor(n, rule.NewContainer(rule.DefaultConfig), "test")
+	mode := maintenance.New(maintenance.State{})
+	apiExecutor.SetMaintenance(mode)
+	handler := NewHandler(n, apiExecutor, Config{})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api", strings.NewReader(`{"id": 1, "method": "maintenance", "params": {"enabled": true, "message": "back soon"}}`)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"id": 1, "result": {"enabled": true, "message": "back soon", "block_subscribe": false}}`, rec.Body.String())
+	require.Equal(t, maintenance.State{Enabled: true, Message: "back soon"}, mode.State())
+}
+
+func TestFlatJSONReplyEncoder(t *testing.T) {
+	encoder := NewFlatJSONReplyEncoder()
+	require.NoError(t, encoder.Encode(&Reply{ID: 1, Result: Raw(`{}`)}))
+	require.NoError(t, encoder.Encode(&Reply{Result: Raw(`{"a":1}`)}))
+	require.NoError(t, encoder.Encode(&Reply{ID: 2}))
+	require.NoError(t, encoder.Encode(&Reply{Error: ErrorInternal}))
+	require.Equal(t, "{\"id\":1}\n{\"a\":1}\n{\"id\":2}\n{\"error\":\"internal server error\",\"code\":100}\n", string(encoder.Finish()))
+}
+
+func TestFlatJSONReplyEncoderCollision(t *testing.T) {
+	encoder := NewFlatJSONReplyEncoder()
+	require.NoError(t, encoder.Encode(&Reply{ID: 1, Result: Raw(`{"id":"x"}`)}))
+	require.NoError(t, encoder.Encode(&Reply{ID: 2, Result: Raw(`{"error":"x","a":1}`)}))
+	require.NoError(t, encoder.Encode(&Reply{Result: Raw(`{"code":1}`)}))
+	require.Equal(t, "{\"id\":1,\"result\":{\"id\":\"x\"}}\n{\"id\":2,\"result\":{\"error\":\"x\",\"a\":1}}\n{\"result\":{\"code\":1}}\n", string(encoder.Finish()))
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+)
+
+// InfoDecoder decodes raw connection or channel info into a typed value.
+// Wire format of info stays JSON – decoder only changes the way info is
+// seen by Go code which embeds Centrifugo API.
+type InfoDecoder func(data []byte) (interface{}, error)
+
+// NewJSONInfoDecoder returns InfoDecoder which unmarshals JSON info into a new
+// value of the same type as prototype. Decoded value is always a pointer, so
+// for prototype MyInfo{} decoder returns *MyInfo.
+func NewJSONInfoDecoder(prototype interface{}) InfoDecoder {
+	typ := reflect.TypeOf(prototype)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return func(data []byte) (interface{}, error) {
+		v := reflect.New(typ).Interface()
+		if len(data) == 0 {
+			return v, nil
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+// TypedClientInfo is a ClientInfo with ConnInfo and ChanInfo decoded using
+// InfoDecoder registered in Executor. If no decoder registered for info then
+// corresponding field contains Raw info. Node is set as in ClientInfo.
+type TypedClientInfo struct {
+	User     string
+	Client   string
+	ConnInfo interface{}
+	ChanInfo interface{}
+	Node     string
+}
+
+// SetInfoDecoder registers decoders for connection info and channel info.
+// Any of decoders can be nil.
+func (h *Executor) SetInfoDecoder(connInfo InfoDecoder, chanInfo InfoDecoder) {
+	h.connInfoDecoder = connInfo
+	h.chanInfoDecoder = chanInfo
+}
+
+func decodeInfo(decoder InfoDecoder, data Raw) (interface{}, error) {
+	if decoder == nil {
+		return data, nil
+	}
+	return decoder(data)
+}
+
+// TypedPresence returns presence information for channel with info
+// decoded using registered InfoDecoder.
+func (h *Executor) TypedPresence(ctx context.Context, cmd *PresenceRequest) (map[string]*TypedClientInfo, error) {
+	resp := h.Presence(ctx, cmd)
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	presence := make(map[string]*TypedClientInfo, len(resp.Result.Presence))
+	for k, v := range resp.Result.Presence {
+		connInfo, err := decodeInfo(h.connInfoDecoder, v.ConnInfo)
+		if err != nil {
+			return nil, err
+		}
+		chanInfo, err := decodeInfo(h.chanInfoDecoder, v.ChanInfo)
+		if err != nil {
+			return nil, err
+		}
+		presence[k] = &TypedClientInfo{
+			User:     v.User,
+			Client:   v.Client,
+			ConnInfo: connInfo,
+			ChanInfo: chanInfo,
+			Node:     v.Node,
+		}
+	}
+	return presence, nil
+}
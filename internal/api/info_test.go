@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/presence"
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+type testConnInfo struct {
+	Name  string `json:"name"`
+	Admin bool   `json:"admin"`
+}
+
+func TestJSONInfoDecoder(t *testing.T) {
+	decoder := NewJSONInfoDecoder(testConnInfo{})
+	v, err := decoder([]byte(`{"name":"Alex","admin":true}`))
+	require.NoError(t, err)
+	require.Equal(t, &testConnInfo{Name: "Alex", Admin: true}, v)
+
+	v, err = decoder(nil)
+	require.NoError(t, err)
+	require.Equal(t, &testConnInfo{}, v)
+
+	_, err = decoder([]byte(`{"name":`))
+	require.Error(t, err)
+}
+
+func TestTypedPresenceAPI(t *testing.T) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	node.SetEngine(engine)
+	require.NoError(t, node.Run())
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Presence = true
+	ruleContainer := rule.NewContainer(ruleConfig)
+
+	api := NewExecutor(node, ruleContainer, "test")
+
+	err = engine.AddPresence("test", "client", &centrifuge.ClientInfo{
+		ClientID: "client",
+		UserID:   "user",
+		ConnInfo: []byte(`{"name":"Alex","admin":true}`),
+		ChanInfo: []byte(`{"role":"reader"}`),
+	}, time.Minute)
+	require.NoError(t, err)
+
+	presence, err := api.TypedPresence(context.Background(), &PresenceRequest{Channel: "test"})
+	require.NoError(t, err)
+	require.Len(t, presence, 1)
+	require.Equal(t, Raw(`{"name":"Alex","admin":true}`), presence["client"].ConnInfo)
+
+	api.SetInfoDecoder(NewJSONInfoDecoder(&testConnInfo{}), nil)
+	presence, err = api.TypedPresence(context.Background(), &PresenceRequest{Channel: "test"})
+	require.NoError(t, err)
+	require.Len(t, presence, 1)
+	require.Equal(t, "user", presence["client"].User)
+	require.Equal(t, "client", presence["client"].Client)
+	require.Equal(t, &testConnInfo{Name: "Alex", Admin: true}, presence["client"].ConnInfo)
+	require.Equal(t, Raw(`{"role":"reader"}`), presence["client"].ChanInfo)
+	require.Equal(t, "", presence["client"].Node)
+
+	_, err = api.TypedPresence(context.Background(), &PresenceRequest{Channel: "test:test"})
+	require.Equal(t, ErrorNamespaceNotFound, err)
+}
+
+func TestTypedPresenceAPINode(t *testing.T) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	node.SetEngine(engine)
+	require.NoError(t, node.Run())
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Presence = true
+	ruleConfig.PresenceNodeName = true
+	api := NewExecutor(node, rule.NewContainer(ruleConfig), "test")
+
+	err = engine.AddPresence("test", "client", &centrifuge.ClientInfo{
+		ClientID: "client",
+		UserID:   "user",
+	}, time.Minute)
+	require.NoError(t, err)
+	err = engine.AddPresence(presence.NodeChannel("test"), "client", &centrifuge.ClientInfo{
+		ClientID: "client",
+		UserID:   "user",
+		ConnInfo: []byte("node1"),
+	}, time.Minute)
+	require.NoError(t, err)
+
+	// Node is the same in untyped and typed client info.
+	resp := api.Presence(context.Background(), &PresenceRequest{Channel: "test"})
+	require.Nil(t, resp.Error)
+	require.Equal(t, "node1", resp.Result.Presence["client"].Node)
+
+	typed, err := api.TypedPresence(context.Background(), &PresenceRequest{Channel: "test"})
+	require.NoError(t, err)
+	require.Len(t, typed, 1)
+	require.Equal(t, "node1", typed["client"].Node)
+}
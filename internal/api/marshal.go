@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"strconv"
 )
 
 // ReplyEncoder ...
@@ -47,6 +48,93 @@ func (e *JSONReplyEncoder) Finish() []byte {
 	return dataCopy
 }
 
+// FlatJSONReplyEncoder encodes replies with result fields on top level.
+type FlatJSONReplyEncoder struct {
+	buffer bytes.Buffer
+}
+
+// NewFlatJSONReplyEncoder ...
+func NewFlatJSONReplyEncoder() *FlatJSONReplyEncoder {
+	return &FlatJSONReplyEncoder{}
+}
+
+// Reset ...
+func (e *FlatJSONReplyEncoder) Reset() {
+	e.buffer.Reset()
+}
+
+type flatErrorReply struct {
+	ID    uint32 `json:"id,omitempty"`
+	Error string `json:"error"`
+	Code  uint32 `json:"code"`
+}
+
+// flatReplyKeys are keys of flat reply envelope.
+var flatReplyKeys = []string{"id", "error", "code"}
+
+// flattenable checks result is JSON object without fields which collide
+// with flat reply envelope keys.
+func flattenable(result []byte) bool {
+	if len(result) < 2 || result[0] != '{' {
+		return false
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(result, &fields); err != nil {
+		return false
+	}
+	for _, key := range flatReplyKeys {
+		if _, ok := fields[key]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Encode encodes reply with result fields on top level. Result which is
+// not JSON object or contains fields named as envelope keys (id, error or
+// code) encoded in wrapped envelope so fields never shadowed.
+func (e *FlatJSONReplyEncoder) Encode(r *Reply) error {
+	if r.Error != nil {
+		data, err := json.Marshal(flatErrorReply{ID: r.ID, Error: r.Error.Message, Code: r.Error.Code})
+		if err != nil {
+			return err
+		}
+		e.buffer.Write(data)
+		e.buffer.WriteString("\n")
+		return nil
+	}
+	result := bytes.TrimSpace(r.Result)
+	if !flattenable(result) {
+		data, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		e.buffer.Write(data)
+		e.buffer.WriteString("\n")
+		return nil
+	}
+	fields := bytes.TrimSpace(result[1 : len(result)-1])
+	e.buffer.WriteByte('{')
+	if r.ID > 0 {
+		e.buffer.WriteString(`"id":`)
+		e.buffer.WriteString(strconv.FormatUint(uint64(r.ID), 10))
+		if len(fields) > 0 {
+			e.buffer.WriteByte(',')
+		}
+	}
+	e.buffer.Write(fields)
+	e.buffer.WriteString("}\n")
+	return nil
+}
+
+// Finish ...
+func (e *FlatJSONReplyEncoder) Finish() []byte {
+	data := e.buffer.Bytes()
+	dataCopy := make([]byte, len(data))
+	copy(dataCopy, data)
+	return dataCopy
+}
+
 // ProtobufReplyEncoder ...
 type ProtobufReplyEncoder struct {
 	buffer bytes.Buffer
@@ -91,10 +179,13 @@ type Encoder interface {
 	EncodeDisconnect(*DisconnectResult) ([]byte, error)
 	EncodePresence(*PresenceResult) ([]byte, error)
 	EncodePresenceStats(*PresenceStatsResult) ([]byte, error)
+	EncodeAggregatedPresenceStats(*AggregatedPresenceStatsResult) ([]byte, error)
 	EncodeHistory(*HistoryResult) ([]byte, error)
 	EncodeHistoryRemove(*HistoryRemoveResult) ([]byte, error)
 	EncodeChannels(*ChannelsResult) ([]byte, error)
 	EncodeInfo(*InfoResult) ([]byte, error)
+	EncodeEngineHealth(*EngineHealthResult) ([]byte, error)
+	EncodeMaintenance(*MaintenanceResult) ([]byte, error)
 	EncodeRPC(*RPCResult) ([]byte, error)
 }
 
@@ -136,6 +227,11 @@ func (e *JSONEncoder) EncodePresenceStats(res *PresenceStatsResult) ([]byte, err
 	return json.Marshal(res)
 }
 
+// EncodeAggregatedPresenceStats ...
+func (e *JSONEncoder) EncodeAggregatedPresenceStats(res *AggregatedPresenceStatsResult) ([]byte, error) {
+	return json.Marshal(res)
+}
+
 // EncodeHistory ...
 func (e *JSONEncoder) EncodeHistory(res *HistoryResult) ([]byte, error) {
 	return json.Marshal(res)
@@ -156,6 +252,16 @@ func (e *JSONEncoder) EncodeInfo(res *InfoResult) ([]byte, error) {
 	return json.Marshal(res)
 }
 
+// EncodeEngineHealth ...
+func (e *JSONEncoder) EncodeEngineHealth(res *EngineHealthResult) ([]byte, error) {
+	return json.Marshal(res)
+}
+
+// EncodeMaintenance ...
+func (e *JSONEncoder) EncodeMaintenance(res *MaintenanceResult) ([]byte, error) {
+	return json.Marshal(res)
+}
+
 // EncodeRPC ...
 func (e *JSONEncoder) EncodeRPC(res *RPCResult) ([]byte, error) {
 	return json.Marshal(res)
@@ -199,6 +305,11 @@ func (e *ProtobufEncoder) EncodePresenceStats(res *PresenceStatsResult) ([]byte,
 	return res.Marshal()
 }
 
+// EncodeAggregatedPresenceStats ...
+func (e *ProtobufEncoder) EncodeAggregatedPresenceStats(res *AggregatedPresenceStatsResult) ([]byte, error) {
+	return res.Marshal()
+}
+
 // EncodeHistory ...
 func (e *ProtobufEncoder) EncodeHistory(res *HistoryResult) ([]byte, error) {
 	return res.Marshal()
@@ -219,6 +330,16 @@ func (e *ProtobufEncoder) EncodeInfo(res *InfoResult) ([]byte, error) {
 	return res.Marshal()
 }
 
+// EncodeEngineHealth ...
+func (e *ProtobufEncoder) EncodeEngineHealth(res *EngineHealthResult) ([]byte, error) {
+	return res.Marshal()
+}
+
+// EncodeMaintenance ...
+func (e *ProtobufEncoder) EncodeMaintenance(res *MaintenanceResult) ([]byte, error) {
+	return res.Marshal()
+}
+
 // EncodeRPC ...
 func (e *ProtobufEncoder) EncodeRPC(res *RPCResult) ([]byte, error) {
 	return res.Marshal()
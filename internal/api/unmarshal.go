@@ -102,10 +102,12 @@ type Decoder interface {
 	DecodeDisconnect([]byte) (*DisconnectRequest, error)
 	DecodePresence([]byte) (*PresenceRequest, error)
 	DecodePresenceStats([]byte) (*PresenceStatsRequest, error)
+	DecodeAggregatedPresenceStats([]byte) (*AggregatedPresenceStatsRequest, error)
 	DecodeHistory([]byte) (*HistoryRequest, error)
 	DecodeHistoryRemove([]byte) (*HistoryRemoveRequest, error)
 	DecodeChannels([]byte) (*ChannelsRequest, error)
 	DecodeInfo([]byte) (*InfoRequest, error)
+	DecodeMaintenance([]byte) (*MaintenanceRequest, error)
 	DecodeRPC([]byte) (*RPCRequest, error)
 }
 
@@ -177,6 +179,16 @@ func (d *JSONDecoder) DecodePresenceStats(data []byte) (*PresenceStatsRequest, e
 	return &p, nil
 }
 
+// DecodeAggregatedPresenceStats ...
+func (d *JSONDecoder) DecodeAggregatedPresenceStats(data []byte) (*AggregatedPresenceStatsRequest, error) {
+	var p AggregatedPresenceStatsRequest
+	err := json.Unmarshal(data, &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
 // DecodeHistory ...
 func (d *JSONDecoder) DecodeHistory(data []byte) (*HistoryRequest, error) {
 	var p HistoryRequest
@@ -217,6 +229,16 @@ func (d *JSONDecoder) DecodeInfo(data []byte) (*InfoRequest, error) {
 	return &p, nil
 }
 
+// DecodeMaintenance ...
+func (d *JSONDecoder) DecodeMaintenance(data []byte) (*MaintenanceRequest, error) {
+	var p MaintenanceRequest
+	err := json.Unmarshal(data, &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
 // DecodeRPC ...
 func (d *JSONDecoder) DecodeRPC(data []byte) (*RPCRequest, error) {
 	var p RPCRequest
@@ -295,6 +317,16 @@ func (d *ProtobufDecoder) DecodePresenceStats(data []byte) (*PresenceStatsReques
 	return &p, nil
 }
 
+// DecodeAggregatedPresenceStats ...
+func (d *ProtobufDecoder) DecodeAggregatedPresenceStats(data []byte) (*AggregatedPresenceStatsRequest, error) {
+	var p AggregatedPresenceStatsRequest
+	err := p.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
 // DecodeHistory ...
 func (d *ProtobufDecoder) DecodeHistory(data []byte) (*HistoryRequest, error) {
 	var p HistoryRequest
@@ -335,6 +367,16 @@ func (d *ProtobufDecoder) DecodeInfo(data []byte) (*InfoRequest, error) {
 	return &p, nil
 }
 
+// DecodeMaintenance ...
+func (d *ProtobufDecoder) DecodeMaintenance(data []byte) (*MaintenanceRequest, error) {
+	var p MaintenanceRequest
+	err := p.Unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
 // DecodeRPC ...
 func (d *ProtobufDecoder) DecodeRPC(data []byte) (*RPCRequest, error) {
 	var p RPCRequest
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// ErrorNodeChannelLimit returned on subscribe to a new channel when number
+// of channels on node reached limit set by SetNodeChannelLimit.
+var ErrorNodeChannelLimit = &centrifuge.Error{
+	Code:    1003,
+	Message: "node channel limit reached",
+}
+
+// channelRegistry tells how many channels node has and how many subscribers
+// channel has on node. Implemented by centrifuge.Hub.
+type channelRegistry interface {
+	NumChannels() int
+	NumSubscribers(ch string) int
+}
+
+// channelLimiter enforces node-wide limit of channels. Channels already
+// present in registry are always allowed. Subscriptions to new channels
+// reserved until subscription added to registry so concurrent subscriptions
+// to distinct channels can't exceed limit.
+type channelLimiter struct {
+	registry channelRegistry
+
+	mu      sync.Mutex
+	pending map[string]int
+}
+
+func newChannelLimiter(registry channelRegistry) *channelLimiter {
+	return &channelLimiter{
+		registry: registry,
+		pending:  make(map[string]int),
+	}
+}
+
+// reserve returns false if subscription to channel not allowed by limit.
+// Otherwise returned function must be called once subscription processed.
+// Zero limit means no limit.
+func (l *channelLimiter) reserve(ch string, limit int) (func(), bool) {
+	if limit <= 0 {
+		return func() {}, true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.pending[ch]; !ok && l.registry.NumSubscribers(ch) == 0 {
+		numChannels := l.registry.NumChannels()
+		for pendingCh := range l.pending {
+			if l.registry.NumSubscribers(pendingCh) == 0 {
+				numChannels++
+			}
+		}
+		if numChannels >= limit {
+			return nil, false
+		}
+	}
+	l.pending[ch]++
+	return func() { l.release(ch) }, true
+}
+
+func (l *channelLimiter) release(ch string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pending[ch]--
+	if l.pending[ch] <= 0 {
+		delete(l.pending, ch)
+	}
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/centrifugal/centrifugo/internal/jwtverify"
+	"github.com/centrifugal/centrifugo/internal/proxy"
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/centrifugal/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+type testChannelRegistry struct {
+	subscribers map[string]int
+}
+
+func (r *testChannelRegistry) NumChannels() int {
+	return len(r.subscribers)
+}
+
+func (r *testChannelRegistry) NumSubscribers(ch string) int {
+	return r.subscribers[ch]
+}
+
+func TestChannelLimiter(t *testing.T) {
+	registry := &testChannelRegistry{subscribers: map[string]int{"a": 1}}
+	l := newChannelLimiter(registry)
+
+	_, ok := l.reserve("b", 0)
+	require.True(t, ok)
+	_, ok = l.reserve("b", 1)
+	require.False(t, ok)
+	// Existing channel always allowed.
+	releaseA, ok := l.reserve("a", 1)
+	require.True(t, ok)
+	releaseA()
+
+	releaseB, ok := l.reserve("b", 2)
+	require.True(t, ok)
+	// Pending channel counted.
+	_, ok = l.reserve("c", 2)
+	require.False(t, ok)
+	// Same pending channel allowed.
+	releaseB2, ok := l.reserve("b", 2)
+	require.True(t, ok)
+	releaseB()
+	releaseB2()
+	require.Len(t, l.pending, 0)
+
+	// Pending channel already present in registry not counted twice.
+	releaseC, ok := l.reserve("c", 3)
+	require.True(t, ok)
+	registry.subscribers["c"] = 1
+	releaseD, ok := l.reserve("d", 3)
+	require.True(t, ok)
+	releaseC()
+	releaseD()
+}
+
+func newChannelLimitHandler(node *centrifuge.Node, limit int) {
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientInsecure = true
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	h.SetNodeChannelLimit(limit)
+	h.Setup()
+}
+
+func connectedClient(t *testing.T, node *centrifuge.Node) (*centrifuge.Client, func() error) {
+	client, closeFn, err := centrifuge.NewClient(context.Background(), node, newTestTransport())
+	require.NoError(t, err)
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 1})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+	return client, closeFn
+}
+
+func TestClientNodeChannelLimit(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	newChannelLimitHandler(node, 2)
+
+	client1, closeFn1 := connectedClient(t, node)
+	defer func() { _ = closeFn1() }()
+	client2, closeFn2 := connectedClient(t, node)
+	defer func() { _ = closeFn2() }()
+	client3, closeFn3 := connectedClient(t, node)
+	defer func() { _ = closeFn3() }()
+
+	require.True(t, client1.Handle(subscribeCommand(t, 2, "a", "")))
+	require.True(t, client2.Handle(subscribeCommand(t, 2, "b", "")))
+	require.Equal(t, []string{"a"}, client1.Channels())
+	require.Equal(t, []string{"b"}, client2.Channels())
+
+	// New channel rejected, existing channel allowed.
+	require.True(t, client3.Handle(subscribeCommand(t, 2, "c", "")))
+	require.Len(t, client3.Channels(), 0)
+	require.True(t, client3.Handle(subscribeCommand(t, 3, "a", "")))
+	require.Equal(t, []string{"a"}, client3.Channels())
+	require.Equal(t, 2, node.Hub().NumChannels())
+
+	// Channel released once last subscriber left.
+	require.NoError(t, client2.Unsubscribe("b"))
+	require.True(t, client3.Handle(subscribeCommand(t, 4, "c", "")))
+	require.Len(t, client3.Channels(), 2)
+}
+
+func TestClientNodeChannelLimitError(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	newChannelLimitHandler(node, 1)
+
+	client1, closeFn1 := connectedClient(t, node)
+	defer func() { _ = closeFn1() }()
+	require.True(t, client1.Handle(subscribeCommand(t, 2, "a", "")))
+
+	transport := newTestTransport()
+	transport.sink = make(chan []byte, 10)
+	client2, closeFn2, err := centrifuge.NewClient(context.Background(), node, transport)
+	require.NoError(t, err)
+	defer func() { _ = closeFn2() }()
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 1})
+	require.NoError(t, err)
+	require.True(t, client2.Handle(data))
+	<-transport.sink
+	require.True(t, client2.Handle(subscribeCommand(t, 2, "b", "")))
+	reply := <-transport.sink
+	require.Contains(t, string(reply), `"code":1003`)
+	require.Contains(t, string(reply), "node channel limit reached")
+}
+
+func TestClientNodeChannelLimitConcurrent(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	const limit = 10
+	newChannelLimitHandler(node, limit)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5*limit; i++ {
+		client, closeFn := connectedClient(t, node)
+		defer func() { _ = closeFn() }()
+		cmd := subscribeCommand(t, 2, "channel"+strconv.Itoa(i), "")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.Handle(cmd)
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, limit, node.Hub().NumChannels())
+}
@@ -2,10 +2,12 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 
 	"github.com/centrifugal/centrifugo/internal/jwtverify"
+	"github.com/centrifugal/centrifugo/internal/maintenance"
 	"github.com/centrifugal/centrifugo/internal/proxy"
 	"github.com/centrifugal/centrifugo/internal/rule"
 
@@ -21,9 +23,35 @@ func proxyHTTPClient(timeout time.Duration) *http.Client {
 	}
 }
 
+// ErrorPresenceFull returned on subscribe when channel presence reached
+// configured max size.
+var ErrorPresenceFull = &centrifuge.Error{
+	Code:    1001,
+	Message: "presence full",
+}
+
+// ErrorMaintenance returned on subscribe when maintenance mode with
+// subscriptions blocked enabled.
+var ErrorMaintenance = &centrifuge.Error{
+	Code:    1002,
+	Message: "maintenance",
+}
+
+// ErrorInvalidChannel returned on subscribe to channel which name can't be
+// unambiguously split into namespace and allowed users parts.
+var ErrorInvalidChannel = &centrifuge.Error{
+	Code:    1004,
+	Message: "invalid channel name",
+}
+
 // RPCExtensionFunc ...
 type RPCExtensionFunc func(c *centrifuge.Client, e centrifuge.RPCEvent) (centrifuge.RPCReply, error)
 
+// ChannelNamer returns name of channel which must be used instead of channel
+// supplied by client. ChannelNamer must be idempotent – i.e. return the same
+// name when called with already transformed channel name.
+type ChannelNamer func(c *centrifuge.Client, ch string) string
+
 // Handler ...
 type Handler struct {
 	node          *centrifuge.Node
@@ -31,6 +59,13 @@ type Handler struct {
 	tokenVerifier jwtverify.Verifier
 	proxyConfig   proxy.Config
 	rpcExtension  map[string]RPCExtensionFunc
+	sessions      *sessionStore
+	channelNamer  ChannelNamer
+	channelLimit  *channelLimiter
+
+	nodeChannelLimit  int
+	presenceRefresher PresenceRefresher
+	maintenance       *maintenance.Mode
 }
 
 // NewHandler ...
@@ -46,6 +81,8 @@ func NewHandler(
 		tokenVerifier: tokenVerifier,
 		proxyConfig:   proxyConfig,
 		rpcExtension:  make(map[string]RPCExtensionFunc),
+		sessions:      newSessionStore(),
+		channelLimit:  newChannelLimiter(node.Hub()),
 	}
 }
 
@@ -54,6 +91,63 @@ func (h Handler) SetRPCExtension(method string, handler RPCExtensionFunc) {
 	h.rpcExtension[method] = handler
 }
 
+// SetChannelNamer sets hook to transform channel names supplied by client in
+// publish, presence, presence stats and history requests. Centrifuge does not
+// allow changing channel of subscription so subscribe requests must use
+// transformed channel name, subscriptions to channels which ChannelNamer
+// would transform are rejected.
+func (h *Handler) SetChannelNamer(namer ChannelNamer) {
+	h.channelNamer = namer
+}
+
+// PresenceRefresher prolongs presence entries of client.
+type PresenceRefresher interface {
+	Refresh(clientID string) error
+}
+
+// SetPresenceRefresher sets PresenceRefresher called upon client commands so
+// presence of active connections refreshed without waiting for periodic
+// presence update. Must be called before node started.
+func (h *Handler) SetPresenceRefresher(r PresenceRefresher) {
+	h.presenceRefresher = r
+}
+
+// SetNodeChannelLimit limits number of distinct channels client connections
+// of node subscribed to. Client subscriptions to new channels rejected once
+// limit reached. Zero value means no limit. Must be called before node started.
+func (h *Handler) SetNodeChannelLimit(limit int) {
+	h.nodeChannelLimit = limit
+}
+
+// SetMaintenance sets maintenance mode checked upon client connect and
+// subscribe. Must be called before node started.
+func (h *Handler) SetMaintenance(m *maintenance.Mode) {
+	h.maintenance = m
+}
+
+func (h *Handler) maintenanceState() maintenance.State {
+	if h.maintenance == nil {
+		return maintenance.State{}
+	}
+	return h.maintenance.State()
+}
+
+func (h *Handler) observeActivity(c *centrifuge.Client) {
+	if h.presenceRefresher == nil {
+		return
+	}
+	if err := h.presenceRefresher.Refresh(c.ID()); err != nil {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error refreshing presence", map[string]interface{}{"error": err.Error(), "user": c.UserID(), "client": c.ID()}))
+	}
+}
+
+func (h *Handler) channelName(c *centrifuge.Client, ch string) string {
+	if h.channelNamer == nil {
+		return ch
+	}
+	return h.channelNamer(c, ch)
+}
+
 // Setup event handlers.
 func (h *Handler) Setup() {
 	var connectProxyHandler centrifuge.ConnectingHandler
@@ -75,9 +169,22 @@ func (h *Handler) Setup() {
 				proxyHTTPClient(h.proxyConfig.RefreshTimeout),
 				proxy.WithExtraHeaders(h.proxyConfig.ExtraHTTPHeaders),
 			),
+			InfoHMACSecretKey: h.proxyConfig.RefreshInfoHMACSecretKey,
 		}).Handle(h.node)
 	}
 
+	if h.ruleContainer.Config().ClientServerTime {
+		if _, ok := h.rpcExtension[TimeRPCMethod]; !ok {
+			h.rpcExtension[TimeRPCMethod] = h.timeRPC
+		}
+	}
+
+	if h.ruleContainer.Config().ClientPresencePing {
+		if _, ok := h.rpcExtension[PresencePingRPCMethod]; !ok {
+			h.rpcExtension[PresencePingRPCMethod] = h.presencePingRPC
+		}
+	}
+
 	h.node.OnConnecting(func(ctx context.Context, e centrifuge.ConnectEvent) (centrifuge.ConnectReply, error) {
 		return h.OnClientConnecting(ctx, e, connectProxyHandler, refreshProxyHandler != nil)
 	})
@@ -121,6 +228,12 @@ func (h *Handler) Setup() {
 	concurrency := ruleConfig.ClientConcurrency
 
 	h.node.OnConnect(func(client *centrifuge.Client) {
+		if state := h.maintenanceState(); state.Enabled {
+			if err := client.Send(maintenance.Notice(state.Message)); err != nil {
+				h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error sending maintenance notice", map[string]interface{}{"error": err.Error(), "client": client.ID(), "user": client.UserID()}))
+			}
+		}
+
 		userID := client.UserID()
 		if usePersonalChannel && singleConnection && userID != "" {
 			personalChannel := h.ruleContainer.PersonalChannel(userID)
@@ -157,41 +270,84 @@ func (h *Handler) Setup() {
 
 		if rpcProxyHandler != nil || len(h.rpcExtension) > 0 {
 			client.OnRPC(func(event centrifuge.RPCEvent, cb centrifuge.RPCCallback) {
+				h.observeActivity(client)
 				h.runConcurrentlyIfNeeded(concurrency, semaphore, func() {
 					cb(h.OnRPC(client, event, rpcProxyHandler))
 				})
 			})
 		}
 
+		subTimers := newSubscriptionTimers()
+		pendingSession, _ := h.sessions.takePending(client.ID())
+		sessChannels := newSessionChannels()
+
 		client.OnSubscribe(func(event centrifuge.SubscribeEvent, cb centrifuge.SubscribeCallback) {
+			h.observeActivity(client)
 			h.runConcurrentlyIfNeeded(concurrency, semaphore, func() {
-				cb(h.OnSubscribe(client, event, subscribeProxyHandler))
+				reply, subExpireAt, err := h.onSubscribe(client, event, subscribeProxyHandler)
+				if err == nil {
+					release, ok := h.channelLimit.reserve(event.Channel, h.nodeChannelLimit)
+					if !ok {
+						h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "node channel limit reached", map[string]interface{}{"channel": event.Channel, "user": client.UserID(), "client": client.ID()}))
+						reply, err = centrifuge.SubscribeReply{}, ErrorNodeChannelLimit
+					} else {
+						defer release()
+					}
+				}
+				if err == nil && pendingSession != nil {
+					sessChannels.subscribe(event.Channel)
+				}
+				if err == nil && subExpireAt > 0 {
+					channel := event.Channel
+					subTimers.set(channel, time.Until(time.Unix(subExpireAt, 0)), func() {
+						h.expireSubscription(client, channel)
+					})
+				}
+				cb(reply, err)
 			})
 		})
 
+		client.OnUnsubscribe(func(event centrifuge.UnsubscribeEvent) {
+			subTimers.stop(event.Channel)
+			if pendingSession != nil {
+				sessChannels.unsubscribe(event.Channel)
+			}
+		})
+
+		client.OnDisconnect(func(event centrifuge.DisconnectEvent) {
+			subTimers.stopAll()
+			if pendingSession != nil {
+				h.saveSession(pendingSession, sessChannels.list(), event.Disconnect)
+			}
+		})
+
 		client.OnSubRefresh(func(event centrifuge.SubRefreshEvent, cb centrifuge.SubRefreshCallback) {
 			cb(h.OnSubRefresh(client, event))
 		})
 
 		client.OnPublish(func(event centrifuge.PublishEvent, cb centrifuge.PublishCallback) {
+			h.observeActivity(client)
 			h.runConcurrentlyIfNeeded(concurrency, semaphore, func() {
 				cb(h.OnPublish(client, event, publishProxyHandler))
 			})
 		})
 
 		client.OnPresence(func(event centrifuge.PresenceEvent, cb centrifuge.PresenceCallback) {
+			h.observeActivity(client)
 			h.runConcurrentlyIfNeeded(concurrency, semaphore, func() {
 				cb(h.OnPresence(client, event))
 			})
 		})
 
 		client.OnPresenceStats(func(event centrifuge.PresenceStatsEvent, cb centrifuge.PresenceStatsCallback) {
+			h.observeActivity(client)
 			h.runConcurrentlyIfNeeded(concurrency, semaphore, func() {
 				cb(h.OnPresenceStats(client, event))
 			})
 		})
 
 		client.OnHistory(func(event centrifuge.HistoryEvent, cb centrifuge.HistoryCallback) {
+			h.observeActivity(client)
 			h.runConcurrentlyIfNeeded(concurrency, semaphore, func() {
 				cb(h.OnHistory(client, event))
 			})
@@ -227,7 +383,32 @@ func (h *Handler) OnClientConnecting(
 
 	ruleConfig := h.ruleContainer.Config()
 
-	if e.Token != "" {
+	var restored *session
+	if ruleConfig.ClientSessionTTL > 0 {
+		restored = h.restoreSession(e)
+	}
+
+	// Set when connection accepted without credentials only because some
+	// namespaces are insecure.
+	insecureNamespaces := false
+
+	if restored != nil {
+		credentials = &restored.credentials
+		insecureNamespaces = restored.insecureNamespaces
+		for ch, opts := range restored.subscriptions {
+			subscriptions[ch] = opts
+		}
+		// Channels client was subscribed to returned to client instead of
+		// subscribing server-side: client subscribes to them again so every
+		// subscription passes subscribe permission checks and proxy.
+		if len(restored.channels) > 0 {
+			channels, err := json.Marshal(restored.channels)
+			if err != nil {
+				return centrifuge.ConnectReply{}, err
+			}
+			data = withJSONField(data, "channels", string(channels))
+		}
+	} else if e.Token != "" {
 		token, err := h.tokenVerifier.VerifyConnectToken(e.Token)
 		if err != nil {
 			if err == jwtverify.ErrTokenExpired {
@@ -276,10 +457,11 @@ func (h *Handler) OnClientConnecting(
 	}
 
 	// Proceed with Credentials with empty user ID in case anonymous or insecure options on.
-	if credentials == nil && (ruleConfig.ClientAnonymous || ruleConfig.ClientInsecure) {
+	if credentials == nil && (ruleConfig.ClientAnonymous || ruleConfig.ClientInsecure || ruleConfig.HasInsecureNamespace()) {
 		credentials = &centrifuge.Credentials{
 			UserID: "",
 		}
+		insecureNamespaces = !ruleConfig.ClientAnonymous && !ruleConfig.ClientInsecure
 	}
 
 	// Automatically subscribe on personal server-side channel.
@@ -301,18 +483,75 @@ func (h *Handler) OnClientConnecting(
 		}
 	}
 
-	return centrifuge.ConnectReply{
+	if ruleConfig.ClientServerTime && e.Transport != nil && e.Transport.Encoding() == centrifuge.EncodingTypeJSON {
+		data = withServerTime(data, serverTimeMillis())
+	}
+
+	if ruleConfig.ClientSessionTTL > 0 && credentials != nil && e.Transport != nil && e.Transport.Encoding() == centrifuge.EncodingTypeJSON {
+		sessionToken, err := generateSessionToken()
+		if err != nil {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error generating session token", map[string]interface{}{"error": err.Error(), "client": e.ClientID}))
+			return centrifuge.ConnectReply{}, err
+		}
+		serverSubscriptions := make(map[string]centrifuge.SubscribeOptions, len(subscriptions))
+		for ch, opts := range subscriptions {
+			serverSubscriptions[ch] = opts
+		}
+		h.sessions.addPending(e.ClientID, &pendingSession{
+			token:              sessionToken,
+			credentials:        *credentials,
+			subscriptions:      serverSubscriptions,
+			insecureNamespaces: insecureNamespaces,
+		}, ruleConfig.ClientSessionTTL)
+		data = withSessionToken(data, sessionToken)
+	}
+
+	if refreshProxyEnabled && credentials != nil && credentials.UserID != "" {
+		credentials.ExpireAt = h.refreshExpireAt(credentials.ExpireAt)
+	}
+
+	// Assigned after personal channel subscription and refresh setup since
+	// such user ID does not identify real user.
+	if credentials != nil && credentials.UserID == "" && (ruleConfig.ClientInsecure || insecureNamespaces) && ruleConfig.ClientInsecureUniqueUser {
+		credentials.UserID = e.ClientID
+	}
+
+	reply := centrifuge.ConnectReply{
 		Credentials:       credentials,
 		Subscriptions:     subscriptions,
 		Data:              data,
 		ClientSideRefresh: !refreshProxyEnabled,
-	}, nil
+	}
+	if insecureNamespaces {
+		reply.Context = withInsecureNamespaces(ctx)
+	}
+	return reply, nil
+}
+
+// refreshExpireAt limits connection expiration time so that refresh proxy
+// called at least every RefreshInterval.
+func (h *Handler) refreshExpireAt(expireAt int64) int64 {
+	if h.proxyConfig.RefreshInterval <= 0 {
+		return expireAt
+	}
+	refreshAt := time.Now().Add(h.proxyConfig.RefreshInterval).Unix()
+	if expireAt == 0 || expireAt > refreshAt {
+		return refreshAt
+	}
+	return expireAt
 }
 
 // OnRefresh ...
 func (h *Handler) OnRefresh(c *centrifuge.Client, e centrifuge.RefreshEvent, refreshProxyHandler proxy.RefreshHandlerFunc) (centrifuge.RefreshReply, error) {
 	if refreshProxyHandler != nil {
-		return refreshProxyHandler(c, e)
+		reply, err := refreshProxyHandler(c, e)
+		if err == nil && !reply.Expired {
+			if c.UserID() != "" {
+				reply.ExpireAt = h.refreshExpireAt(reply.ExpireAt)
+			}
+			h.recheckSubscriptions(c)
+		}
+		return reply, err
 	}
 	token, err := h.tokenVerifier.VerifyConnectToken(e.Token)
 	if err != nil {
@@ -322,14 +561,54 @@ func (h *Handler) OnRefresh(c *centrifuge.Client, e centrifuge.RefreshEvent, ref
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "invalid refresh token", map[string]interface{}{"error": err.Error(), "client": c.ID()}))
 		return centrifuge.RefreshReply{}, centrifuge.DisconnectInvalidToken
 	}
+	h.recheckSubscriptions(c)
 	return centrifuge.RefreshReply{
 		ExpireAt: token.ExpireAt,
 		Info:     token.Info,
 	}, nil
 }
 
+// recheckSubscriptions unsubscribes refreshed connection from channels it's
+// not allowed to be subscribed to according to current channel options –
+// for example when namespace removed or anonymous access turned off with
+// configuration reload after client subscribed.
+func (h *Handler) recheckSubscriptions(c *centrifuge.Client) {
+	for _, ch := range c.Channels() {
+		if h.subscriptionAllowed(c, ch) {
+			continue
+		}
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "subscription not allowed anymore", map[string]interface{}{"channel": ch, "user": c.UserID(), "client": c.ID()}))
+		if err := c.Unsubscribe(ch); err != nil {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error unsubscribing not allowed subscription", map[string]interface{}{"error": err.Error(), "channel": ch, "user": c.UserID(), "client": c.ID()}))
+		}
+	}
+}
+
+// subscriptionAllowed checks existing subscription against current channel
+// options. Checks happening once on subscribe (tokens, proxy) not repeated.
+func (h *Handler) subscriptionAllowed(c *centrifuge.Client, ch string) bool {
+	chOpts, found, err := h.ruleContainer.ChannelOptions(ch)
+	if err != nil {
+		return true
+	}
+	if !found {
+		return false
+	}
+	if !chOpts.Anonymous && c.UserID() == "" && !h.ruleContainer.InsecureChannel(ch) {
+		return false
+	}
+	if h.insecureNamespacesOnly(c) && !h.ruleContainer.InsecureChannel(ch) {
+		return false
+	}
+	return h.ruleContainer.UserAllowed(ch, c.UserID())
+}
+
 // OnRPC ...
 func (h *Handler) OnRPC(c *centrifuge.Client, e centrifuge.RPCEvent, rpcProxyHandler proxy.RPCHandlerFunc) (centrifuge.RPCReply, error) {
+	if h.insecureNamespacesOnly(c) {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "rpc not allowed for connection without credentials", map[string]interface{}{"method": e.Method, "client": c.ID()}))
+		return centrifuge.RPCReply{}, centrifuge.ErrorPermissionDenied
+	}
 	if handler, ok := h.rpcExtension[e.Method]; ok {
 		return handler(c, e)
 	}
@@ -360,65 +639,113 @@ func (h *Handler) OnSubRefresh(c *centrifuge.Client, e centrifuge.SubRefreshEven
 
 // OnSubscribe ...
 func (h *Handler) OnSubscribe(c *centrifuge.Client, e centrifuge.SubscribeEvent, subscribeProxyHandler proxy.SubscribeHandlerFunc) (centrifuge.SubscribeReply, error) {
-	ruleConfig := h.ruleContainer.Config()
+	reply, _, err := h.onSubscribe(c, e, subscribeProxyHandler)
+	return reply, err
+}
+
+// onSubscribe additionally returns Unix time when subscription must be
+// removed, zero means subscription does not expire.
+func (h *Handler) onSubscribe(c *centrifuge.Client, e centrifuge.SubscribeEvent, subscribeProxyHandler proxy.SubscribeHandlerFunc) (centrifuge.SubscribeReply, int64, error) {
+	if state := h.maintenanceState(); state.Enabled && state.BlockSubscribe {
+		return centrifuge.SubscribeReply{}, 0, ErrorMaintenance
+	}
+
+	if ch := h.channelName(c, e.Channel); ch != e.Channel {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "attempt to subscribe on channel with untransformed name", map[string]interface{}{"channel": e.Channel, "expected": ch, "user": c.UserID(), "client": c.ID()}))
+		return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorPermissionDenied
+	}
+
+	if err := h.ruleContainer.ValidateChannel(e.Channel); err != nil {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "invalid channel name", map[string]interface{}{"error": err.Error(), "channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
+		return centrifuge.SubscribeReply{}, 0, ErrorInvalidChannel
+	}
 
 	chOpts, found, err := h.ruleContainer.ChannelOptions(e.Channel)
 	if err != nil {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "subscribe channel options error", map[string]interface{}{"error": err.Error(), "channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
-		return centrifuge.SubscribeReply{}, err
+		return centrifuge.SubscribeReply{}, 0, err
 	}
 	if !found {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "subscribe unknown channel", map[string]interface{}{"channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
-		return centrifuge.SubscribeReply{}, centrifuge.ErrorUnknownChannel
+		return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorUnknownChannel
 	}
 
 	if chOpts.ServerSide {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "attempt to subscribe on server side channel", map[string]interface{}{"channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
-		return centrifuge.SubscribeReply{}, centrifuge.ErrorPermissionDenied
+		return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorPermissionDenied
 	}
 
-	if !chOpts.Anonymous && c.UserID() == "" && !ruleConfig.ClientInsecure {
+	if !chOpts.Anonymous && c.UserID() == "" && !h.ruleContainer.InsecureChannel(e.Channel) {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "anonymous user is not allowed to subscribe on channel", map[string]interface{}{"channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
-		return centrifuge.SubscribeReply{}, centrifuge.ErrorPermissionDenied
+		return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorPermissionDenied
+	}
+
+	if h.insecureNamespacesOnly(c) && !h.ruleContainer.InsecureChannel(e.Channel) {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "connection without credentials is not allowed to subscribe on channel of secure namespace", map[string]interface{}{"channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
+		return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorPermissionDenied
 	}
 
 	if !h.ruleContainer.UserAllowed(e.Channel, c.UserID()) {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "user is not allowed to subscribe on channel", map[string]interface{}{"channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
-		return centrifuge.SubscribeReply{}, centrifuge.ErrorPermissionDenied
+		return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorPermissionDenied
 	}
 
 	var (
 		channelInfo []byte
 		expireAt    int64
+		subExpireAt int64
 	)
 
 	if h.ruleContainer.IsTokenChannel(e.Channel) {
 		if e.Token == "" {
 			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "subscription token required", map[string]interface{}{"client": c.ID(), "user": c.UserID()}))
-			return centrifuge.SubscribeReply{}, centrifuge.ErrorPermissionDenied
+			return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorPermissionDenied
 		}
 		token, err := h.tokenVerifier.VerifySubscribeToken(e.Token)
 		if err != nil {
 			if err == jwtverify.ErrTokenExpired {
-				return centrifuge.SubscribeReply{}, centrifuge.ErrorTokenExpired
+				return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorTokenExpired
 			}
 			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "invalid subscription token", map[string]interface{}{"error": err.Error(), "client": c.ID(), "user": c.UserID()}))
-			return centrifuge.SubscribeReply{}, centrifuge.ErrorPermissionDenied
+			return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorPermissionDenied
 		}
 		if c.ID() != token.Client || e.Channel != token.Channel {
-			return centrifuge.SubscribeReply{}, centrifuge.ErrorPermissionDenied
+			return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorPermissionDenied
 		}
 		expireAt = token.ExpireAt
 		if token.ExpireTokenOnly {
 			expireAt = 0
 		}
 		channelInfo = token.Info
+		subExpireAt = token.SubscriptionExpireAt
+		if chOpts.ExpireUnsubscribe && expireAt > 0 {
+			// Track token expiration for this subscription only – so that
+			// expired subscription removed while connection and other
+			// subscriptions stay alive.
+			if subExpireAt == 0 || expireAt < subExpireAt {
+				subExpireAt = expireAt
+			}
+			expireAt = 0
+		}
 	} else if chOpts.ProxySubscribe && !h.ruleContainer.IsUserLimited(e.Channel) {
 		if subscribeProxyHandler == nil {
 			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "subscribe proxy not enabled", map[string]interface{}{"channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
-			return centrifuge.SubscribeReply{}, centrifuge.ErrorNotAvailable
+			return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorNotAvailable
+		}
+		reply, err := subscribeProxyHandler(c, e, chOpts)
+		return reply, 0, err
+	}
+
+	if chOpts.Presence && chOpts.PresenceMaxSize > 0 && chOpts.PresenceEvictionPolicy != rule.PresenceEvictionOldest {
+		stats, err := h.node.PresenceStats(e.Channel)
+		if err != nil {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error getting presence stats", map[string]interface{}{"error": err.Error(), "channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
+			return centrifuge.SubscribeReply{}, 0, centrifuge.ErrorInternal
+		}
+		if stats.NumClients >= chOpts.PresenceMaxSize {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "channel presence is full", map[string]interface{}{"channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
+			return centrifuge.SubscribeReply{}, 0, ErrorPresenceFull
 		}
-		return subscribeProxyHandler(c, e, chOpts)
 	}
 
 	return centrifuge.SubscribeReply{
@@ -427,15 +754,19 @@ func (h *Handler) OnSubscribe(c *centrifuge.Client, e centrifuge.SubscribeEvent,
 			ChannelInfo: channelInfo,
 			Presence:    chOpts.Presence,
 			JoinLeave:   chOpts.JoinLeave,
-			Recover:     chOpts.HistoryRecover,
+			Recover:     chOpts.HistoryRecover || chOpts.SubscribeState,
 		},
 		ClientSideRefresh: true,
-	}, nil
+	}, subExpireAt, nil
 }
 
 // OnPublish ...
 func (h *Handler) OnPublish(c *centrifuge.Client, e centrifuge.PublishEvent, publishProxyHandler proxy.PublishHandlerFunc) (centrifuge.PublishReply, error) {
-	ruleConfig := h.ruleContainer.Config()
+	renamed := false
+	if ch := h.channelName(c, e.Channel); ch != e.Channel {
+		e.Channel = ch
+		renamed = true
+	}
 
 	chOpts, found, err := h.ruleContainer.ChannelOptions(e.Channel)
 	if err != nil {
@@ -447,7 +778,11 @@ func (h *Handler) OnPublish(c *centrifuge.Client, e centrifuge.PublishEvent, pub
 		return centrifuge.PublishReply{}, centrifuge.ErrorUnknownChannel
 	}
 
-	if !chOpts.Publish && !ruleConfig.ClientInsecure {
+	if !chOpts.Publish && !h.ruleContainer.InsecureChannel(e.Channel) {
+		return centrifuge.PublishReply{}, centrifuge.ErrorPermissionDenied
+	}
+
+	if h.insecureNamespacesOnly(c) && !h.ruleContainer.InsecureChannel(e.Channel) {
 		return centrifuge.PublishReply{}, centrifuge.ErrorPermissionDenied
 	}
 
@@ -462,7 +797,12 @@ func (h *Handler) OnPublish(c *centrifuge.Client, e centrifuge.PublishEvent, pub
 			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "publish proxy not enabled", map[string]interface{}{"channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
 			return centrifuge.PublishReply{}, centrifuge.ErrorNotAvailable
 		}
-		return publishProxyHandler(c, e, chOpts)
+		reply, err := publishProxyHandler(c, e, chOpts)
+		if err == nil && renamed && reply.Result == nil {
+			// Prevent Centrifuge from publishing into original channel.
+			reply.Result = &centrifuge.PublishResult{}
+		}
+		return reply, err
 	}
 
 	result, err := h.node.Publish(
@@ -475,6 +815,9 @@ func (h *Handler) OnPublish(c *centrifuge.Client, e centrifuge.PublishEvent, pub
 
 // OnPresence ...
 func (h *Handler) OnPresence(c *centrifuge.Client, e centrifuge.PresenceEvent) (centrifuge.PresenceReply, error) {
+	ch := h.channelName(c, e.Channel)
+	renamed := ch != e.Channel
+	e.Channel = ch
 	chOpts, found, err := h.ruleContainer.ChannelOptions(e.Channel)
 	if err != nil {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "presence channel options error", map[string]interface{}{"error": err.Error(), "channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
@@ -490,11 +833,21 @@ func (h *Handler) OnPresence(c *centrifuge.Client, e centrifuge.PresenceEvent) (
 	if !c.IsSubscribed(e.Channel) {
 		return centrifuge.PresenceReply{}, centrifuge.ErrorPermissionDenied
 	}
+	if renamed {
+		result, err := h.node.Presence(e.Channel)
+		if err != nil {
+			return centrifuge.PresenceReply{}, err
+		}
+		return centrifuge.PresenceReply{Result: &result}, nil
+	}
 	return centrifuge.PresenceReply{}, nil
 }
 
 // OnPresenceStats ...
 func (h *Handler) OnPresenceStats(c *centrifuge.Client, e centrifuge.PresenceStatsEvent) (centrifuge.PresenceStatsReply, error) {
+	ch := h.channelName(c, e.Channel)
+	renamed := ch != e.Channel
+	e.Channel = ch
 	chOpts, found, err := h.ruleContainer.ChannelOptions(e.Channel)
 	if err != nil {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "presence stats channel options error", map[string]interface{}{"error": err.Error(), "channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
@@ -510,11 +863,21 @@ func (h *Handler) OnPresenceStats(c *centrifuge.Client, e centrifuge.PresenceSta
 	if !c.IsSubscribed(e.Channel) {
 		return centrifuge.PresenceStatsReply{}, centrifuge.ErrorPermissionDenied
 	}
+	if renamed {
+		result, err := h.node.PresenceStats(e.Channel)
+		if err != nil {
+			return centrifuge.PresenceStatsReply{}, err
+		}
+		return centrifuge.PresenceStatsReply{Result: &result}, nil
+	}
 	return centrifuge.PresenceStatsReply{}, nil
 }
 
 // OnHistory ...
 func (h *Handler) OnHistory(c *centrifuge.Client, e centrifuge.HistoryEvent) (centrifuge.HistoryReply, error) {
+	ch := h.channelName(c, e.Channel)
+	renamed := ch != e.Channel
+	e.Channel = ch
 	chOpts, found, err := h.ruleContainer.ChannelOptions(e.Channel)
 	if err != nil {
 		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "history channel options error", map[string]interface{}{"error": err.Error(), "channel": e.Channel, "user": c.UserID(), "client": c.ID()}))
@@ -530,5 +893,12 @@ func (h *Handler) OnHistory(c *centrifuge.Client, e centrifuge.HistoryEvent) (ce
 	if !c.IsSubscribed(e.Channel) {
 		return centrifuge.HistoryReply{}, centrifuge.ErrorPermissionDenied
 	}
+	if renamed {
+		result, err := h.node.History(e.Channel, centrifuge.WithLimit(centrifuge.NoLimit))
+		if err != nil {
+			return centrifuge.HistoryReply{}, err
+		}
+		return centrifuge.HistoryReply{Result: &result}, nil
+	}
 	return centrifuge.HistoryReply{}, nil
 }
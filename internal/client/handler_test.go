@@ -4,12 +4,18 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/json"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/centrifugal/centrifugo/internal/jwtverify"
+	"github.com/centrifugal/centrifugo/internal/maintenance"
+	"github.com/centrifugal/centrifugo/internal/middleware"
 	"github.com/centrifugal/centrifugo/internal/proxy"
 	"github.com/centrifugal/centrifugo/internal/rule"
 
@@ -236,6 +242,25 @@ func TestClientConnectNoCredentialsNoTokenAnonymous(t *testing.T) {
 	require.Equal(t, "", reply.Credentials.UserID)
 }
 
+func TestClientConnectNoCredentialsNoTokenInsecureNamespace(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{
+		Name:           "demo",
+		ChannelOptions: rule.ChannelOptions{Insecure: true},
+	}}
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{}, nil, false)
+	require.NoError(t, err)
+
+	require.NotNil(t, reply.Credentials)
+	require.Equal(t, "", reply.Credentials.UserID)
+}
+
 func TestClientConnectWithMalformedToken(t *testing.T) {
 	node := nodeWithMemoryEngine()
 	defer func() { _ = node.Shutdown(context.Background()) }()
@@ -549,6 +574,42 @@ func TestClientSubscribeChannelUserLimited(t *testing.T) {
 	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
 }
 
+func TestClientSubscribeChannelName(t *testing.T) {
+	conf := centrifuge.DefaultConfig
+	conf.ChannelMaxLength = 16
+	node, err := centrifuge.New(conf)
+	require.NoError(t, err)
+	require.NoError(t, node.Run())
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientInsecure = true
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+	h.Setup()
+
+	transport := newTestTransport()
+	transport.sink = make(chan []byte, 10)
+	client, closeFn, err := centrifuge.NewClient(context.Background(), node, transport)
+	require.NoError(t, err)
+	defer func() { _ = closeFn() }()
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 1})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+	<-transport.sink
+
+	require.True(t, client.Handle(subscribeCommand(t, 2, "very_long_channel_name", "")))
+	require.Contains(t, string(<-transport.sink), `"code":106`)
+
+	require.True(t, client.Handle(subscribeCommand(t, 3, "news#1#2", "")))
+	reply := string(<-transport.sink)
+	require.Contains(t, reply, `"code":1004`)
+	require.Contains(t, reply, "invalid channel name")
+
+	require.True(t, client.Handle(subscribeCommand(t, 4, "news", "")))
+	require.NotContains(t, string(<-transport.sink), "error")
+	require.Equal(t, []string{"news"}, client.Channels())
+}
+
 func TestClientSubscribePrivateChannelWithToken(t *testing.T) {
 	node := nodeWithMemoryEngineNoHandlers()
 	defer func() { _ = node.Shutdown(context.Background()) }()
@@ -881,6 +942,54 @@ func TestClientPublishAllowed(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestClientInsecureNamespace(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{
+		{Name: "demo", ChannelOptions: rule.ChannelOptions{Insecure: true}},
+		{Name: "private"},
+	}
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+
+	_, err := h.OnSubscribe(&centrifuge.Client{}, centrifuge.SubscribeEvent{
+		Channel: "demo:test",
+	}, nil)
+	require.NoError(t, err)
+	_, err = h.OnPublish(&centrifuge.Client{}, centrifuge.PublishEvent{
+		Channel: "demo:test",
+		Data:    []byte(`{}`),
+	}, nil)
+	require.NoError(t, err)
+
+	_, err = h.OnSubscribe(&centrifuge.Client{}, centrifuge.SubscribeEvent{
+		Channel: "private:test",
+	}, nil)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+	_, err = h.OnPublish(&centrifuge.Client{}, centrifuge.PublishEvent{
+		Channel: "private:test",
+		Data:    []byte(`{}`),
+	}, nil)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+
+	// Node-wide insecure mode works as fallback for all namespaces.
+	ruleConfig.ClientInsecure = true
+	require.NoError(t, ruleContainer.Reload(ruleConfig))
+	_, err = h.OnSubscribe(&centrifuge.Client{}, centrifuge.SubscribeEvent{
+		Channel: "private:test",
+	}, nil)
+	require.NoError(t, err)
+	_, err = h.OnPublish(&centrifuge.Client{}, centrifuge.PublishEvent{
+		Channel: "private:test",
+		Data:    []byte(`{}`),
+	}, nil)
+	require.NoError(t, err)
+}
+
 func TestClientSubscribeToPublish(t *testing.T) {
 	node := nodeWithMemoryEngineNoHandlers()
 	defer func() { _ = node.Shutdown(context.Background()) }()
@@ -1062,3 +1171,527 @@ func TestClientPresenceStatsError(t *testing.T) {
 	})
 	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
 }
+
+func TestClientSubscribePresenceFull(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	node.SetPresenceManager(engine)
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientInsecure = true
+	ruleConfig.Presence = true
+	ruleConfig.PresenceMaxSize = 1
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+
+	transport := newTestTransport()
+	client, closeFn, err := centrifuge.NewClient(context.Background(), node, transport)
+	require.NoError(t, err)
+	defer func() { _ = closeFn() }()
+
+	_, err = h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "test"}, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, engine.AddPresence("test", "other", &centrifuge.ClientInfo{ClientID: "other"}, time.Minute))
+	_, err = h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "test"}, nil)
+	require.Equal(t, ErrorPresenceFull, err)
+
+	ruleConfig.PresenceEvictionPolicy = rule.PresenceEvictionOldest
+	require.NoError(t, ruleContainer.Reload(ruleConfig))
+	_, err = h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "test"}, nil)
+	require.NoError(t, err)
+}
+
+func connectClientWithToken(t *testing.T, node *centrifuge.Node, token string) (*centrifuge.Client, func() error) {
+	client, closeFn, err := centrifuge.NewClient(context.Background(), node, newTestTransport())
+	require.NoError(t, err)
+	params, err := json.Marshal(&protocol.ConnectRequest{Token: token})
+	require.NoError(t, err)
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{
+		ID:     1,
+		Method: protocol.MethodTypeConnect,
+		Params: params,
+	})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+	return client, closeFn
+}
+
+// tenantChannelNamer puts tenant part of user ID (before dot) after
+// channel namespace.
+func tenantChannelNamer(c *centrifuge.Client, ch string) string {
+	tenant := strings.SplitN(c.UserID(), ".", 2)[0]
+	parts := strings.SplitN(ch, ":", 2)
+	if len(parts) != 2 || strings.HasPrefix(parts[1], tenant+"/") {
+		return ch
+	}
+	return parts[0] + ":" + tenant + "/" + parts[1]
+}
+
+func TestClientChannelNamerTenantIsolation(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{
+		Name: "room",
+		ChannelOptions: rule.ChannelOptions{
+			Publish:         true,
+			Presence:        true,
+			HistorySize:     10,
+			HistoryLifetime: 300,
+		},
+	}}
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	h.SetChannelNamer(tenantChannelNamer)
+	h.Setup()
+
+	clientA, closeA := connectClientWithToken(t, node, getConnTokenHS("a.1", 0))
+	defer func() { _ = closeA() }()
+	clientB, closeB := connectClientWithToken(t, node, getConnTokenHS("b.1", 0))
+	defer func() { _ = closeB() }()
+
+	// Subscribe must use transformed channel name.
+	_, err := h.OnSubscribe(clientA, centrifuge.SubscribeEvent{Channel: "room:1"}, nil)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+	_, err = h.OnSubscribe(clientB, centrifuge.SubscribeEvent{Channel: "room:a/1"}, nil)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+	require.True(t, clientA.Handle(subscribeCommand(t, 2, "room:a/1", "")))
+	require.True(t, clientB.Handle(subscribeCommand(t, 2, "room:b/1", "")))
+	require.Equal(t, []string{"room:a/1"}, clientA.Channels())
+	require.Equal(t, []string{"room:b/1"}, clientB.Channels())
+
+	publishReply, err := h.OnPublish(clientA, centrifuge.PublishEvent{Channel: "room:1", Data: []byte(`{"tenant":"a"}`)}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, publishReply.Result)
+
+	historyReply, err := h.OnHistory(clientA, centrifuge.HistoryEvent{Channel: "room:1"})
+	require.NoError(t, err)
+	require.NotNil(t, historyReply.Result)
+	require.Len(t, historyReply.Result.Publications, 1)
+	require.Equal(t, []byte(`{"tenant":"a"}`), historyReply.Result.Publications[0].Data)
+
+	historyReply, err = h.OnHistory(clientB, centrifuge.HistoryEvent{Channel: "room:1"})
+	require.NoError(t, err)
+	require.NotNil(t, historyReply.Result)
+	require.Len(t, historyReply.Result.Publications, 0)
+
+	presenceReply, err := h.OnPresence(clientB, centrifuge.PresenceEvent{Channel: "room:1"})
+	require.NoError(t, err)
+	require.NotNil(t, presenceReply.Result)
+	require.Len(t, presenceReply.Result.Presence, 1)
+	require.Contains(t, presenceReply.Result.Presence, clientB.ID())
+
+	statsReply, err := h.OnPresenceStats(clientA, centrifuge.PresenceStatsEvent{Channel: "room:1"})
+	require.NoError(t, err)
+	require.NotNil(t, statsReply.Result)
+	require.Equal(t, 1, statsReply.Result.NumClients)
+}
+
+type recordingPresenceRefresher struct {
+	mu      sync.Mutex
+	clients []string
+}
+
+func (r *recordingPresenceRefresher) Refresh(clientID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.clients = append(r.clients, clientID)
+	return nil
+}
+
+func TestClientActivityRefreshesPresence(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Presence = true
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	refresher := &recordingPresenceRefresher{}
+	h.SetPresenceRefresher(refresher)
+	h.Setup()
+
+	client, closeFn := connectClientWithToken(t, node, getConnTokenHS("42", 0))
+	defer func() { _ = closeFn() }()
+	require.True(t, client.Handle(subscribeCommand(t, 2, "chat", "")))
+	require.Equal(t, []string{client.ID()}, refresher.clients)
+}
+
+func TestClientChannelRootDisable(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ChannelRootDisable = true
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{Name: "public", ChannelOptions: rule.ChannelOptions{Publish: true}}}
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	h.Setup()
+
+	client, closeFn := connectClientWithToken(t, node, getConnTokenHS("42", 0))
+	defer func() { _ = closeFn() }()
+
+	_, err := h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "news"}, nil)
+	require.Equal(t, centrifuge.ErrorUnknownChannel, err)
+	_, err = h.OnPublish(client, centrifuge.PublishEvent{Channel: "news", Data: []byte(`{}`)}, nil)
+	require.Equal(t, centrifuge.ErrorUnknownChannel, err)
+
+	_, err = h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "public:news"}, nil)
+	require.NoError(t, err)
+	_, err = h.OnPublish(client, centrifuge.PublishEvent{Channel: "public:news", Data: []byte(`{}`)}, nil)
+	require.NoError(t, err)
+}
+
+func TestClientDuplicateSubscribe(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{Name: "news", ChannelOptions: rule.ChannelOptions{Presence: true}}}
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	h.Setup()
+
+	transport := newTestTransport()
+	transport.sink = make(chan []byte, 10)
+	client, closeFn, err := centrifuge.NewClient(context.Background(), node, transport)
+	require.NoError(t, err)
+	defer func() { _ = closeFn() }()
+	params, err := json.Marshal(&protocol.ConnectRequest{Token: getConnTokenHS("42", 0)})
+	require.NoError(t, err)
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 1, Method: protocol.MethodTypeConnect, Params: params})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+
+	readReply := func() *protocol.Reply {
+		select {
+		case data := <-transport.sink:
+			var reply protocol.Reply
+			require.NoError(t, json.Unmarshal(data, &reply))
+			return &reply
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for reply")
+		}
+		return nil
+	}
+	require.Nil(t, readReply().Error)
+
+	require.True(t, client.Handle(subscribeCommand(t, 2, "news:sport", "")))
+	require.Nil(t, readReply().Error)
+	require.True(t, client.Handle(subscribeCommand(t, 3, "news:sport", "")))
+	reply := readReply()
+	require.NotNil(t, reply.Error)
+	require.Equal(t, centrifuge.ErrorAlreadySubscribed.Code, reply.Error.Code)
+
+	// Duplicate subscribe does not affect subscription state.
+	require.Equal(t, 1, node.Hub().NumSubscribers("news:sport"))
+	require.Equal(t, []string{"news:sport"}, client.Channels())
+	presence, err := node.Presence("news:sport")
+	require.NoError(t, err)
+	require.Len(t, presence.Presence, 1)
+
+	// Single unsubscribe removes subscription completely.
+	client.Unsubscribe("news:sport")
+	require.Equal(t, 0, node.Hub().NumSubscribers("news:sport"))
+}
+
+func TestClientConnectRefreshInterval(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientAnonymous = true
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{RefreshInterval: time.Minute})
+
+	now := time.Now().Unix()
+	for _, tc := range []struct {
+		expireAt int64
+		min, max int64
+	}{
+		{0, now + 59, now + 61},
+		{now + 3600, now + 59, now + 61},
+		{now + 10, now + 10, now + 10},
+	} {
+		reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+			Token: getConnTokenHS("42", tc.expireAt),
+		}, nil, true)
+		require.NoError(t, err)
+		require.True(t, reply.Credentials.ExpireAt >= tc.min && reply.Credentials.ExpireAt <= tc.max, reply.Credentials.ExpireAt)
+	}
+
+	// Not applied without refresh proxy and for anonymous connections.
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{Token: getConnTokenHS("42", 0)}, nil, false)
+	require.NoError(t, err)
+	require.Zero(t, reply.Credentials.ExpireAt)
+	reply, err = h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{}, nil, true)
+	require.NoError(t, err)
+	require.Zero(t, reply.Credentials.ExpireAt)
+}
+
+func TestClientRefreshIntervalUpdatesInfo(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	var (
+		mu        sync.Mutex
+		numCalls  int
+		refreshed = make(chan struct{}, 10)
+		reply     = `{"result": {"info": {"role": "admin"}}}`
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		numCalls++
+		data := reply
+		mu.Unlock()
+		_, _ = w.Write([]byte(data))
+		refreshed <- struct{}{}
+	}))
+	defer server.Close()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{Name: "public", ChannelOptions: rule.ChannelOptions{
+		Publish: true, HistorySize: 10, HistoryLifetime: 60,
+	}}}
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{
+		RefreshEndpoint: server.URL,
+		RefreshTimeout:  time.Second,
+		RefreshInterval: time.Second,
+	})
+	h.Setup()
+
+	// HTTP proxy expects request headers in client context.
+	var ctx context.Context
+	middleware.HeadersToContext(true, http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+	transport := newTestTransport()
+	client, closeFn, err := centrifuge.NewClient(ctx, node, transport)
+	require.NoError(t, err)
+	defer func() { _ = closeFn() }()
+	params, err := json.Marshal(&protocol.ConnectRequest{Token: getConnTokenHS("42", 0)})
+	require.NoError(t, err)
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 1, Method: protocol.MethodTypeConnect, Params: params})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+
+	// Connection without expiration refreshed.
+	select {
+	case <-refreshed:
+	case <-time.After(3 * time.Second):
+		t.Fatal("timeout waiting for refresh")
+	}
+	// Wait for library to apply refresh reply.
+	time.Sleep(50 * time.Millisecond)
+
+	// Updated info applied to connection.
+	params, err = json.Marshal(&protocol.PublishRequest{Channel: "public:chat", Data: []byte(`{}`)})
+	require.NoError(t, err)
+	data, err = protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 2, Method: protocol.MethodTypePublish, Params: params})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+	history, err := node.History("public:chat", centrifuge.WithLimit(centrifuge.NoLimit))
+	require.NoError(t, err)
+	require.Len(t, history.Publications, 1)
+	require.JSONEq(t, `{"role": "admin"}`, string(history.Publications[0].Info.ConnInfo))
+
+	// Refresh continues and re-evaluates connection.
+	mu.Lock()
+	reply = `{"result": {"expired": true}}`
+	mu.Unlock()
+	select {
+	case <-transport.closeCh:
+	case <-time.After(3 * time.Second):
+		t.Fatal("timeout waiting for disconnect")
+	}
+	require.Equal(t, centrifuge.DisconnectExpired, transport.disconnect)
+	mu.Lock()
+	require.Equal(t, 2, numCalls)
+	mu.Unlock()
+}
+
+func TestClientMaintenance(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{Name: "news"}}
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	mode := maintenance.New(maintenance.State{Enabled: true, Message: "back soon", BlockSubscribe: true})
+	h.SetMaintenance(mode)
+	h.Setup()
+
+	connect := func() (*centrifuge.Client, func() *protocol.Reply, func() error) {
+		transport := newTestTransport()
+		transport.sink = make(chan []byte, 10)
+		client, closeFn, err := centrifuge.NewClient(context.Background(), node, transport)
+		require.NoError(t, err)
+		params, err := json.Marshal(&protocol.ConnectRequest{Token: getConnTokenHS("42", 0)})
+		require.NoError(t, err)
+		data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 1, Method: protocol.MethodTypeConnect, Params: params})
+		require.NoError(t, err)
+		require.True(t, client.Handle(data))
+		// Several replies may be written in one frame separated with new lines.
+		var pending []string
+		readReply := func() *protocol.Reply {
+			if len(pending) == 0 {
+				select {
+				case data := <-transport.sink:
+					pending = strings.Split(strings.TrimSpace(string(data)), "\n")
+				case <-time.After(time.Second):
+					t.Fatal("timeout waiting for reply")
+				}
+			}
+			var reply protocol.Reply
+			require.NoError(t, json.Unmarshal([]byte(pending[0]), &reply))
+			pending = pending[1:]
+			return &reply
+		}
+		return client, readReply, closeFn
+	}
+
+	client, readReply, closeFn := connect()
+	defer func() { _ = closeFn() }()
+	require.Nil(t, readReply().Error)
+
+	// Maintenance notice sent to connected client.
+	reply := readReply()
+	var push protocol.Push
+	require.NoError(t, json.Unmarshal(reply.Result, &push))
+	require.Equal(t, protocol.PushTypeMessage, push.Type)
+	var message protocol.Message
+	require.NoError(t, json.Unmarshal(push.Data, &message))
+	require.JSONEq(t, `{"type": "maintenance", "message": "back soon"}`, string(message.Data))
+
+	require.True(t, client.Handle(subscribeCommand(t, 2, "news:sport", "")))
+	reply = readReply()
+	require.NotNil(t, reply.Error)
+	require.Equal(t, ErrorMaintenance.Code, reply.Error.Code)
+	require.Equal(t, 0, node.Hub().NumSubscribers("news:sport"))
+
+	// Subscriptions allowed when only notice required.
+	mode.Set(maintenance.State{Enabled: true, Message: "back soon"})
+	require.True(t, client.Handle(subscribeCommand(t, 3, "news:sport", "")))
+	require.Nil(t, readReply().Error)
+
+	// No notice after maintenance disabled.
+	mode.Set(maintenance.State{})
+	client, readReply, closeFn = connect()
+	defer func() { _ = closeFn() }()
+	require.Nil(t, readReply().Error)
+	require.True(t, client.Handle(subscribeCommand(t, 2, "news:sport", "")))
+	reply = readReply()
+	require.Nil(t, reply.Error)
+	require.Equal(t, uint32(2), reply.ID)
+}
+
+func TestClientInsecureUniqueUser(t *testing.T) {
+	for _, unique := range []bool{false, true} {
+		node := nodeWithMemoryEngineNoHandlers()
+
+		ruleConfig := rule.DefaultConfig
+		ruleConfig.ClientInsecure = true
+		ruleConfig.ClientInsecureUniqueUser = unique
+		ruleConfig.Presence = true
+		h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+		h.Setup()
+
+		var clientIDs []string
+		for i := 0; i < 2; i++ {
+			client, closeFn := connectClientWithToken(t, node, "")
+			defer func() { _ = closeFn() }()
+			require.True(t, client.Handle(subscribeCommand(t, 2, "chat", "")))
+			clientIDs = append(clientIDs, client.ID())
+			if unique {
+				require.Equal(t, client.ID(), client.UserID())
+			} else {
+				require.Equal(t, "", client.UserID())
+			}
+		}
+
+		stats, err := node.PresenceStats("chat")
+		require.NoError(t, err)
+		require.Equal(t, 2, stats.NumClients)
+		presence, err := node.Presence("chat")
+		require.NoError(t, err)
+		require.Len(t, presence.Presence, 2)
+		if unique {
+			require.Equal(t, 2, stats.NumUsers)
+			for _, clientID := range clientIDs {
+				require.Equal(t, clientID, presence.Presence[clientID].UserID)
+			}
+		} else {
+			require.Equal(t, 1, stats.NumUsers)
+		}
+		_ = node.Shutdown(context.Background())
+	}
+}
+
+func TestClientRefreshRechecksSubscriptions(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientAnonymous = true
+	ruleConfig.Namespaces = []rule.ChannelNamespace{
+		{Name: "news"},
+		{Name: "chat"},
+		{Name: "public", ChannelOptions: rule.ChannelOptions{Anonymous: true}},
+	}
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	h.Setup()
+
+	client, closeFn := connectClientWithToken(t, node, getConnTokenHS("42", 0))
+	defer func() { _ = closeFn() }()
+	require.True(t, client.Handle(subscribeCommand(t, 2, "news:sport", "")))
+	require.True(t, client.Handle(subscribeCommand(t, 3, "chat:1#42", "")))
+	require.True(t, client.Handle(subscribeCommand(t, 4, "public:1", "")))
+	require.ElementsMatch(t, []string{"news:sport", "chat:1#42", "public:1"}, client.Channels())
+
+	anonymous, closeAnonymous := connectClientWithToken(t, node, "")
+	defer func() { _ = closeAnonymous() }()
+	require.True(t, anonymous.Handle(subscribeCommand(t, 2, "public:1", "")))
+	require.Equal(t, []string{"public:1"}, anonymous.Channels())
+
+	// All subscriptions still allowed.
+	_, err := h.OnRefresh(client, centrifuge.RefreshEvent{Token: getConnTokenHS("42", 0)}, nil)
+	require.NoError(t, err)
+	require.Len(t, client.Channels(), 3)
+
+	// Namespace removed and anonymous access turned off with reload.
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{Name: "chat"}, {Name: "public"}}
+	require.NoError(t, ruleContainer.Reload(ruleConfig))
+	_, err = h.OnRefresh(client, centrifuge.RefreshEvent{Token: getConnTokenHS("42", 0)}, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"chat:1#42", "public:1"}, client.Channels())
+	refreshAnonymous := func(_ *centrifuge.Client, _ centrifuge.RefreshEvent) (centrifuge.RefreshReply, error) {
+		return centrifuge.RefreshReply{}, nil
+	}
+	_, err = h.OnRefresh(anonymous, centrifuge.RefreshEvent{}, refreshAnonymous)
+	require.NoError(t, err)
+	require.Len(t, anonymous.Channels(), 0)
+
+	// Subscriptions kept when refresh fails.
+	ruleConfig.Namespaces = nil
+	require.NoError(t, ruleContainer.Reload(ruleConfig))
+	_, err = h.OnRefresh(client, centrifuge.RefreshEvent{Token: "invalid"}, nil)
+	require.Error(t, err)
+	require.Len(t, client.Channels(), 2)
+}
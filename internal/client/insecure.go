@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// insecureNamespacesKey marks context of connection accepted without
+// credentials only because some channel namespaces are insecure.
+type insecureNamespacesKey struct{}
+
+func withInsecureNamespaces(ctx context.Context) context.Context {
+	return context.WithValue(ctx, insecureNamespacesKey{}, true)
+}
+
+// insecureNamespacesOnly returns whether client is limited to channels of
+// insecure namespaces: it was accepted without credentials only because some
+// namespaces are insecure and node-wide client_anonymous and client_insecure
+// options are still off. Such client can not subscribe or publish to other
+// channels (even anonymous ones) and can not call RPC.
+func (h *Handler) insecureNamespacesOnly(c *centrifuge.Client) bool {
+	ctx := c.Context()
+	if ctx == nil {
+		return false
+	}
+	if marked, _ := ctx.Value(insecureNamespacesKey{}).(bool); !marked {
+		return false
+	}
+	ruleConfig := h.ruleContainer.Config()
+	return !ruleConfig.ClientAnonymous && !ruleConfig.ClientInsecure
+}
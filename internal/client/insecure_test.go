@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/jwtverify"
+	"github.com/centrifugal/centrifugo/internal/proxy"
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+func insecureNamespaceConfig() rule.Config {
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{
+		{Name: "demo", ChannelOptions: rule.ChannelOptions{Insecure: true}},
+		{Name: "public", ChannelOptions: rule.ChannelOptions{Anonymous: true, Publish: true}},
+	}
+	return ruleConfig
+}
+
+// insecureNamespaceClient connects client without credentials and returns
+// it with context set by connecting handler.
+func insecureNamespaceClient(t *testing.T, node *centrifuge.Node, h *Handler, clientID string) (*centrifuge.Client, centrifuge.ConnectReply, func() error) {
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{ClientID: clientID}, nil, false)
+	require.NoError(t, err)
+	require.NotNil(t, reply.Credentials)
+	ctx := reply.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	client, closeFn, err := centrifuge.NewClient(ctx, node, newTestTransport())
+	require.NoError(t, err)
+	return client, reply, closeFn
+}
+
+func TestClientInsecureNamespaceOnly(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := insecureNamespaceConfig()
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+	extensionCalled := false
+	h.SetRPCExtension("test", func(_ *centrifuge.Client, _ centrifuge.RPCEvent) (centrifuge.RPCReply, error) {
+		extensionCalled = true
+		return centrifuge.RPCReply{}, nil
+	})
+	proxyCalled := false
+	rpcProxy := func(_ *centrifuge.Client, _ centrifuge.RPCEvent) (centrifuge.RPCReply, error) {
+		proxyCalled = true
+		return centrifuge.RPCReply{}, nil
+	}
+
+	client, reply, closeFn := insecureNamespaceClient(t, node, h, "client")
+	defer func() { _ = closeFn() }()
+	require.Equal(t, "", reply.Credentials.UserID)
+	require.NotNil(t, reply.Context)
+
+	_, err := h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "demo:1"}, nil)
+	require.NoError(t, err)
+	_, err = h.OnPublish(client, centrifuge.PublishEvent{Channel: "demo:1", Data: []byte(`{}`)}, nil)
+	require.NoError(t, err)
+
+	// Anonymous channel of secure namespace not available.
+	_, err = h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "public:1"}, nil)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+	_, err = h.OnPublish(client, centrifuge.PublishEvent{Channel: "public:1", Data: []byte(`{}`)}, nil)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+	require.False(t, h.subscriptionAllowed(client, "public:1"))
+
+	_, err = h.OnRPC(client, centrifuge.RPCEvent{Method: "test"}, rpcProxy)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+	_, err = h.OnRPC(client, centrifuge.RPCEvent{}, rpcProxy)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+	require.False(t, extensionCalled)
+	require.False(t, proxyCalled)
+
+	// Restriction lifted when node-wide anonymous mode turned on.
+	ruleConfig.ClientAnonymous = true
+	require.NoError(t, ruleContainer.Reload(ruleConfig))
+	_, err = h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "public:1"}, nil)
+	require.NoError(t, err)
+	_, err = h.OnRPC(client, centrifuge.RPCEvent{}, rpcProxy)
+	require.NoError(t, err)
+	require.True(t, proxyCalled)
+}
+
+func TestClientInsecureNamespaceNotMarked(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := insecureNamespaceConfig()
+	ruleConfig.ClientAnonymous = true
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+
+	// Connection accepted by client_anonymous is not limited to insecure namespaces.
+	client, reply, closeFn := insecureNamespaceClient(t, node, h, "client")
+	defer func() { _ = closeFn() }()
+	require.Nil(t, reply.Context)
+	_, err := h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "public:1"}, nil)
+	require.NoError(t, err)
+
+	// As well as connection with token.
+	reply, err = h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+		Token: getConnTokenHS("42", 0),
+	}, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, "42", reply.Credentials.UserID)
+	require.Nil(t, reply.Context)
+}
+
+func TestClientInsecureNamespaceUniqueUser(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := insecureNamespaceConfig()
+	ruleConfig.ClientInsecureUniqueUser = true
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+
+	_, reply, closeFn := insecureNamespaceClient(t, node, h, "client")
+	defer func() { _ = closeFn() }()
+	require.Equal(t, "client", reply.Credentials.UserID)
+}
+
+func TestClientInsecureNamespaceSessionRestore(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := insecureNamespaceConfig()
+	ruleConfig.ClientSessionTTL = time.Minute
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+
+	h.saveSession(&pendingSession{token: "token", insecureNamespaces: true}, []string{"public:1"}, nil)
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+		ClientID:  "new",
+		Data:      []byte(`{"session":"token"}`),
+		Transport: newTestTransport(),
+	}, nil, false)
+	require.NoError(t, err)
+	require.NotNil(t, reply.Context)
+
+	client, closeFn, err := centrifuge.NewClient(reply.Context, node, newTestTransport())
+	require.NoError(t, err)
+	defer func() { _ = closeFn() }()
+	_, err = h.OnSubscribe(client, centrifuge.SubscribeEvent{Channel: "public:1"}, nil)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+}
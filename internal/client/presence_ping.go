@@ -0,0 +1,47 @@
+package client
+
+import (
+	"encoding/json"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// PresencePingRPCMethod is a name of RPC method which confirms presence of
+// client in channel when presence ping enabled for clients.
+const PresencePingRPCMethod = "presence_ping"
+
+type presencePingParams struct {
+	Channel string `json:"channel"`
+}
+
+// presencePingRPC checks that presence of client in channel maintained and
+// refreshes it so client SDK can surface presence problems instead of
+// relying on periodic presence update which never reports errors.
+func (h *Handler) presencePingRPC(c *centrifuge.Client, e centrifuge.RPCEvent) (centrifuge.RPCReply, error) {
+	var params presencePingParams
+	if err := json.Unmarshal(e.Data, &params); err != nil || params.Channel == "" {
+		return centrifuge.RPCReply{}, centrifuge.ErrorBadRequest
+	}
+	ch := h.channelName(c, params.Channel)
+	chOpts, found, err := h.ruleContainer.ChannelOptions(ch)
+	if err != nil {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "presence ping channel options error", map[string]interface{}{"error": err.Error(), "channel": ch, "user": c.UserID(), "client": c.ID()}))
+		return centrifuge.RPCReply{}, err
+	}
+	if !found {
+		return centrifuge.RPCReply{}, centrifuge.ErrorUnknownChannel
+	}
+	if !chOpts.Presence {
+		return centrifuge.RPCReply{}, centrifuge.ErrorNotAvailable
+	}
+	if !c.IsSubscribed(ch) {
+		return centrifuge.RPCReply{}, centrifuge.ErrorPermissionDenied
+	}
+	if h.presenceRefresher != nil {
+		if err := h.presenceRefresher.Refresh(c.ID()); err != nil {
+			h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error refreshing presence", map[string]interface{}{"error": err.Error(), "channel": ch, "user": c.UserID(), "client": c.ID()}))
+			return centrifuge.RPCReply{}, centrifuge.ErrorInternal
+		}
+	}
+	return centrifuge.RPCReply{Data: []byte(`{}`)}, nil
+}
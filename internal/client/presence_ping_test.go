@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifugal/centrifugo/internal/jwtverify"
+	"github.com/centrifugal/centrifugo/internal/proxy"
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPresencePingRPC(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientPresencePing = true
+	ruleConfig.Namespaces = []rule.ChannelNamespace{
+		{Name: "presence", ChannelOptions: rule.ChannelOptions{Presence: true}},
+		{Name: "nopresence"},
+	}
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	h.Setup()
+
+	client, closeFn := connectClientWithToken(t, node, getConnTokenHS("42", 0))
+	defer func() { _ = closeFn() }()
+	require.True(t, client.Handle(subscribeCommand(t, 2, "presence:chat", "")))
+	require.True(t, client.Handle(subscribeCommand(t, 3, "nopresence:chat", "")))
+	require.ElementsMatch(t, []string{"presence:chat", "nopresence:chat"}, client.Channels())
+
+	ping := func(data string) (centrifuge.RPCReply, error) {
+		return h.OnRPC(client, centrifuge.RPCEvent{Method: PresencePingRPCMethod, Data: []byte(data)}, nil)
+	}
+
+	reply, err := ping(`{"channel": "presence:chat"}`)
+	require.NoError(t, err)
+	require.Equal(t, `{}`, string(reply.Data))
+
+	_, err = ping(`{"channel": "nopresence:chat"}`)
+	require.Equal(t, centrifuge.ErrorNotAvailable, err)
+	_, err = ping(`{"channel": "presence:other"}`)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+	_, err = ping(`{"channel": "unknown:chat"}`)
+	require.Equal(t, centrifuge.ErrorUnknownChannel, err)
+	_, err = ping(`{}`)
+	require.Equal(t, centrifuge.ErrorBadRequest, err)
+	_, err = ping(`[`)
+	require.Equal(t, centrifuge.ErrorBadRequest, err)
+}
+
+func TestClientPresencePingRPCDisabled(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	h := NewHandler(node, rule.NewContainer(rule.DefaultConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+	h.Setup()
+	_, err := h.OnRPC(&centrifuge.Client{}, centrifuge.RPCEvent{Method: PresencePingRPCMethod, Data: []byte(`{"channel": "chat"}`)}, nil)
+	require.Equal(t, centrifuge.ErrorMethodNotFound, err)
+}
@@ -0,0 +1,233 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// session keeps connection metadata which can be restored by client
+// reconnecting with session token.
+type session struct {
+	credentials   centrifuge.Credentials
+	subscriptions map[string]centrifuge.SubscribeOptions
+	channels      []string
+	expireAt      time.Time
+	// insecureNamespaces is true for connection accepted without credentials
+	// only because some namespaces are insecure.
+	insecureNamespaces bool
+}
+
+// pendingSession is a session of client which passed connecting stage but
+// was not connected yet.
+type pendingSession struct {
+	token              string
+	credentials        centrifuge.Credentials
+	subscriptions      map[string]centrifuge.SubscribeOptions
+	expireAt           time.Time
+	insecureNamespaces bool
+}
+
+// sessionChannels tracks client-side subscriptions of connection. Unsubscribe
+// handler also called for every channel when client disconnects so channels
+// only removed upon next subscription – this way channels client was
+// subscribed to are still known in disconnect handler.
+type sessionChannels struct {
+	mu           sync.Mutex
+	channels     map[string]struct{}
+	unsubscribed map[string]struct{}
+}
+
+func newSessionChannels() *sessionChannels {
+	return &sessionChannels{
+		channels:     make(map[string]struct{}),
+		unsubscribed: make(map[string]struct{}),
+	}
+}
+
+func (c *sessionChannels) subscribe(ch string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for unsubscribed := range c.unsubscribed {
+		delete(c.channels, unsubscribed)
+		delete(c.unsubscribed, unsubscribed)
+	}
+	c.channels[ch] = struct{}{}
+}
+
+func (c *sessionChannels) unsubscribe(ch string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unsubscribed[ch] = struct{}{}
+}
+
+func (c *sessionChannels) list() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	channels := make([]string, 0, len(c.channels))
+	for ch := range c.channels {
+		channels = append(channels, ch)
+	}
+	sort.Strings(channels)
+	return channels
+}
+
+// sessionStore is a node-local short-lived storage of sessions. Sessions
+// become available for restore after client disconnects.
+type sessionStore struct {
+	mu        sync.Mutex
+	pending   map[string]*pendingSession
+	sessions  map[string]*session
+	lastPurge time.Time
+}
+
+func newSessionStore() *sessionStore {
+	return &sessionStore{
+		pending:  make(map[string]*pendingSession),
+		sessions: make(map[string]*session),
+	}
+}
+
+func generateSessionToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// purge removes expired entries, lock must be held outside.
+func (s *sessionStore) purge(now time.Time) {
+	for token, sess := range s.sessions {
+		if now.After(sess.expireAt) {
+			delete(s.sessions, token)
+		}
+	}
+	for clientID, sess := range s.pending {
+		if now.After(sess.expireAt) {
+			delete(s.pending, clientID)
+		}
+	}
+	s.lastPurge = now
+}
+
+func (s *sessionStore) maybePurge(now time.Time, ttl time.Duration) {
+	if now.Sub(s.lastPurge) > ttl {
+		s.purge(now)
+	}
+}
+
+// addPending remembers session of connecting client. Pending entry only
+// lives for ttl if client never becomes connected.
+func (s *sessionStore) addPending(clientID string, p *pendingSession, ttl time.Duration) {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maybePurge(now, ttl)
+	p.expireAt = now.Add(ttl)
+	s.pending[clientID] = p
+}
+
+// takePending returns pending session of connected client.
+func (s *sessionStore) takePending(clientID string) (*pendingSession, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.pending[clientID]
+	if !ok {
+		return nil, false
+	}
+	delete(s.pending, clientID)
+	return p, true
+}
+
+// save makes session available for restore during ttl.
+func (s *sessionStore) save(token string, sess *session, ttl time.Duration) {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maybePurge(now, ttl)
+	sess.expireAt = now.Add(ttl)
+	s.sessions[token] = sess
+}
+
+// take returns session by token. Session can only be restored once.
+func (s *sessionStore) take(token string) (*session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.sessions[token]
+	if !ok {
+		return nil, false
+	}
+	delete(s.sessions, token)
+	if time.Now().After(sess.expireAt) {
+		return nil, false
+	}
+	return sess, true
+}
+
+// sessionTokenFromData extracts session token from JSON connect data.
+func sessionTokenFromData(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	var v struct {
+		Session string `json:"session"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return ""
+	}
+	return v.Session
+}
+
+// withSessionToken adds session token to JSON connect reply data.
+func withSessionToken(data []byte, token string) []byte {
+	return withJSONField(data, "session", strconv.Quote(token))
+}
+
+// restoreSession returns session if connect request data contains token of
+// session which is not expired yet.
+func (h *Handler) restoreSession(e centrifuge.ConnectEvent) *session {
+	token := sessionTokenFromData(e.Data)
+	if token == "" {
+		return nil
+	}
+	sess, ok := h.sessions.take(token)
+	if !ok {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelDebug, "session not found or expired", map[string]interface{}{"client": e.ClientID}))
+		return nil
+	}
+	if sess.credentials.ExpireAt > 0 && sess.credentials.ExpireAt < time.Now().Unix() {
+		// Connection credentials expired while client was away.
+		return nil
+	}
+	return sess
+}
+
+// sessionRestorable returns whether session can be restored after client
+// disconnected with disconnect. Only sessions of connections closed by
+// client or closed normally are kept – connection closed by server (forced
+// disconnect over API, expired or invalid token etc.) must not get its
+// credentials back without a new token.
+func sessionRestorable(disconnect *centrifuge.Disconnect) bool {
+	return disconnect == nil || disconnect.Code == centrifuge.DisconnectNormal.Code
+}
+
+// saveSession makes session of disconnected client available for restore.
+func (h *Handler) saveSession(p *pendingSession, channels []string, disconnect *centrifuge.Disconnect) {
+	ttl := h.ruleContainer.Config().ClientSessionTTL
+	if ttl <= 0 || !sessionRestorable(disconnect) {
+		return
+	}
+	h.sessions.save(p.token, &session{
+		credentials:        p.credentials,
+		subscriptions:      p.subscriptions,
+		channels:           channels,
+		insecureNamespaces: p.insecureNamespaces,
+	}, ttl)
+}
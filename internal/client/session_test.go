@@ -0,0 +1,206 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/jwtverify"
+	"github.com/centrifugal/centrifugo/internal/proxy"
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/centrifugal/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+func sessionTestHandler(t *testing.T, node *centrifuge.Node, ttl time.Duration) *Handler {
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientSessionTTL = ttl
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{
+		Name:           "news",
+		ChannelOptions: rule.ChannelOptions{Presence: true},
+	}}
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	h.Setup()
+	return h
+}
+
+// connectSessionClient connects client with token and returns session token
+// from connect reply data.
+func connectSessionClient(t *testing.T, node *centrifuge.Node, token string) (*centrifuge.Client, func() error, string) {
+	transport := newTestTransport()
+	transport.sink = make(chan []byte, 10)
+	client, closeFn, err := centrifuge.NewClient(context.Background(), node, transport)
+	require.NoError(t, err)
+
+	params, err := json.Marshal(&protocol.ConnectRequest{Token: token})
+	require.NoError(t, err)
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{
+		ID:     1,
+		Method: protocol.MethodTypeConnect,
+		Params: params,
+	})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+
+	var reply struct {
+		Result struct {
+			Data struct {
+				Session string `json:"session"`
+			} `json:"data"`
+		} `json:"result"`
+	}
+	select {
+	case data := <-transport.sink:
+		require.NoError(t, json.Unmarshal(data, &reply))
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for connect reply")
+	}
+	require.NotEmpty(t, reply.Result.Data.Session)
+	return client, closeFn, reply.Result.Data.Session
+}
+
+func TestSessionChannels(t *testing.T) {
+	c := newSessionChannels()
+	c.subscribe("a")
+	c.subscribe("b")
+	c.unsubscribe("a")
+	// Unsubscriptions applied on next subscribe only.
+	require.ElementsMatch(t, []string{"a", "b"}, c.list())
+	c.subscribe("c")
+	require.ElementsMatch(t, []string{"b", "c"}, c.list())
+	c.unsubscribe("b")
+	c.subscribe("b")
+	require.ElementsMatch(t, []string{"b", "c"}, c.list())
+}
+
+func TestClientSessionRestore(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	h := sessionTestHandler(t, node, time.Minute)
+
+	client, closeFn, sessionToken := connectSessionClient(t, node, getConnTokenHS("42", 0))
+	require.True(t, client.Handle(subscribeCommand(t, 2, "news:sport", "")))
+	require.True(t, client.Handle(subscribeCommand(t, 3, "news:weather", "")))
+	require.True(t, client.Handle(subscribeCommand(t, 4, "$private", getSubscribeTokenHS("$private", client.ID(), 0))))
+	require.NoError(t, closeFn())
+
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+		ClientID:  "new",
+		Data:      []byte(`{"session":"` + sessionToken + `"}`),
+		Transport: newTestTransport(),
+	}, nil, false)
+	require.NoError(t, err)
+	require.NotNil(t, reply.Credentials)
+	require.Equal(t, "42", reply.Credentials.UserID)
+	// Client-side subscriptions returned to client to subscribe again.
+	require.Len(t, reply.Subscriptions, 0)
+	require.Contains(t, string(reply.Data), `"channels":["$private","news:sport","news:weather"]`)
+
+	// New session token issued, old one can't be used twice.
+	require.NotContains(t, string(reply.Data), sessionToken)
+	require.Contains(t, string(reply.Data), `"session":`)
+	reply, err = h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+		ClientID: "other",
+		Data:     []byte(`{"session":"` + sessionToken + `"}`),
+	}, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, reply.Credentials)
+}
+
+func TestClientSessionRestoreSubscribeProxy(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientSessionTTL = time.Minute
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{
+		Name:           "proxied",
+		ChannelOptions: rule.ChannelOptions{Anonymous: true, ProxySubscribe: true},
+	}}
+	h := NewHandler(node, rule.NewContainer(ruleConfig), jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+	h.sessions.save("token", &session{
+		credentials: centrifuge.Credentials{UserID: "42"},
+		channels:    []string{"proxied:1"},
+	}, time.Minute)
+
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+		ClientID:  "new",
+		Data:      []byte(`{"session":"token"}`),
+		Transport: newTestTransport(),
+	}, nil, false)
+	require.NoError(t, err)
+	require.NotNil(t, reply.Credentials)
+	require.NotContains(t, reply.Subscriptions, "proxied:1")
+	require.Contains(t, string(reply.Data), `"channels":["proxied:1"]`)
+
+	// Subscription to restored channel goes through subscribe proxy.
+	proxied := false
+	denyProxy := func(_ *centrifuge.Client, _ centrifuge.SubscribeEvent, _ rule.ChannelOptions) (centrifuge.SubscribeReply, error) {
+		proxied = true
+		return centrifuge.SubscribeReply{}, centrifuge.ErrorPermissionDenied
+	}
+	_, err = h.OnSubscribe(&centrifuge.Client{}, centrifuge.SubscribeEvent{Channel: "proxied:1"}, denyProxy)
+	require.Equal(t, centrifuge.ErrorPermissionDenied, err)
+	require.True(t, proxied)
+}
+
+func TestClientSessionNotSavedOnServerDisconnect(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	h := sessionTestHandler(t, node, time.Minute)
+
+	for _, disconnect := range []*centrifuge.Disconnect{
+		centrifuge.DisconnectForceNoReconnect,
+		centrifuge.DisconnectForceReconnect,
+		centrifuge.DisconnectExpired,
+		centrifuge.DisconnectInvalidToken,
+	} {
+		h.saveSession(&pendingSession{token: "token"}, nil, disconnect)
+		_, ok := h.sessions.take("token")
+		require.False(t, ok, disconnect.Reason)
+	}
+	for _, disconnect := range []*centrifuge.Disconnect{nil, centrifuge.DisconnectNormal} {
+		h.saveSession(&pendingSession{token: "token"}, nil, disconnect)
+		_, ok := h.sessions.take("token")
+		require.True(t, ok)
+	}
+}
+
+func TestClientSessionExpired(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	h := sessionTestHandler(t, node, 50*time.Millisecond)
+
+	client, closeFn, sessionToken := connectSessionClient(t, node, getConnTokenHS("42", 0))
+	require.True(t, client.Handle(subscribeCommand(t, 2, "news:sport", "")))
+	require.NoError(t, closeFn())
+
+	time.Sleep(100 * time.Millisecond)
+
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+		ClientID:  "new",
+		Data:      []byte(`{"session":"` + sessionToken + `"}`),
+		Transport: newTestTransport(),
+	}, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, reply.Credentials)
+	require.Len(t, reply.Subscriptions, 0)
+}
+
+func TestClientSessionDisabled(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	h := sessionTestHandler(t, node, 0)
+
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+		Token:     getConnTokenHS("42", 0),
+		Transport: newTestTransport(),
+	}, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, reply.Data)
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// subscriptionTimers keeps per-channel expiration timers of one connection.
+type subscriptionTimers struct {
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+}
+
+func newSubscriptionTimers() *subscriptionTimers {
+	return &subscriptionTimers{
+		timers: make(map[string]*time.Timer),
+	}
+}
+
+// set schedules fn to be called after d. Previous timer for the same
+// channel is stopped.
+func (t *subscriptionTimers) set(ch string, d time.Duration, fn func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if timer, ok := t.timers[ch]; ok {
+		timer.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(d, func() {
+		t.mu.Lock()
+		if t.timers[ch] != timer {
+			// Timer was replaced or stopped concurrently.
+			t.mu.Unlock()
+			return
+		}
+		delete(t.timers, ch)
+		t.mu.Unlock()
+		fn()
+	})
+	t.timers[ch] = timer
+}
+
+func (t *subscriptionTimers) stop(ch string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if timer, ok := t.timers[ch]; ok {
+		timer.Stop()
+		delete(t.timers, ch)
+	}
+}
+
+func (t *subscriptionTimers) stopAll() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for ch, timer := range t.timers {
+		timer.Stop()
+		delete(t.timers, ch)
+	}
+}
+
+// expireSubscription unsubscribes client from channel with advice to
+// resubscribe, so client can obtain new subscription token.
+func (h *Handler) expireSubscription(c *centrifuge.Client, ch string) {
+	subscribed := false
+	for _, channel := range c.Channels() {
+		if channel == ch {
+			subscribed = true
+			break
+		}
+	}
+	if !subscribed {
+		return
+	}
+	h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelDebug, "subscription expired", map[string]interface{}{"channel": ch, "user": c.UserID(), "client": c.ID()}))
+	if err := c.Unsubscribe(ch, centrifuge.WithResubscribe(true)); err != nil {
+		h.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error unsubscribing expired subscription", map[string]interface{}{"error": err.Error(), "channel": ch, "user": c.UserID(), "client": c.ID()}))
+	}
+}
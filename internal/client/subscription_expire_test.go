@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/jwtverify"
+	"github.com/centrifugal/centrifugo/internal/proxy"
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/centrifugal/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+func getExpiringSubscribeTokenHS(channel string, client string, subExpireAt int64) string {
+	token, err := getTokenBuilder(nil).Build(&jwtverify.SubscribeTokenClaims{
+		Channel:              channel,
+		Client:               client,
+		SubscriptionExpireAt: subExpireAt,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return string(token.Raw())
+}
+
+func subscribeCommand(t *testing.T, id uint32, channel string, token string) []byte {
+	params, err := json.Marshal(&protocol.SubscribeRequest{Channel: channel, Token: token})
+	require.NoError(t, err)
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{
+		ID:     id,
+		Method: protocol.MethodTypeSubscribe,
+		Params: params,
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func TestSubscriptionTimers(t *testing.T) {
+	timers := newSubscriptionTimers()
+	fired := make(chan string, 3)
+	timers.set("a", time.Hour, func() { fired <- "a_old" })
+	timers.set("a", time.Millisecond, func() { fired <- "a" })
+	timers.set("b", time.Millisecond, func() { fired <- "b" })
+	timers.stop("b")
+	select {
+	case ch := <-fired:
+		require.Equal(t, "a", ch)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for timer")
+	}
+	timers.set("c", time.Millisecond, func() { fired <- "c" })
+	timers.stopAll()
+	select {
+	case ch := <-fired:
+		t.Fatalf("unexpected timer fired: %s", ch)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestClientSubscriptionExpire(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientInsecure = true
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	h.Setup()
+
+	transport := newTestTransport()
+	client, closeFn, err := centrifuge.NewClient(context.Background(), node, transport)
+	require.NoError(t, err)
+	defer func() { _ = closeFn() }()
+
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 1})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+
+	// Already expired subscription rejected.
+	reply, err := h.OnSubscribe(client, centrifuge.SubscribeEvent{
+		Channel: "$expired",
+		Token:   getExpiringSubscribeTokenHS("$expired", client.ID(), time.Now().Unix()-1),
+	}, nil)
+	require.Equal(t, centrifuge.ErrorTokenExpired, err)
+	require.Zero(t, reply.Options.ExpireAt)
+
+	subExpireAt := time.Now().Unix() + 1
+	require.True(t, client.Handle(subscribeCommand(t, 2, "$test", getExpiringSubscribeTokenHS("$test", client.ID(), subExpireAt))))
+	require.True(t, client.Handle(subscribeCommand(t, 3, "other", "")))
+
+	channels := client.Channels()
+	sort.Strings(channels)
+	require.Equal(t, []string{"$test", "other"}, channels)
+
+	deadline := time.Now().Add(3 * time.Second)
+	for len(client.Channels()) > 1 && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Equal(t, []string{"other"}, client.Channels())
+	require.True(t, time.Now().Unix() >= subExpireAt)
+
+	select {
+	case <-transport.closeCh:
+		t.Fatal("connection must stay alive")
+	default:
+	}
+}
+
+func TestClientExpireUnsubscribe(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientInsecure = true
+	ruleConfig.ExpireUnsubscribe = true
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+	h.Setup()
+
+	transport := newTestTransport()
+	client, closeFn, err := centrifuge.NewClient(context.Background(), node, transport)
+	require.NoError(t, err)
+	defer func() { _ = closeFn() }()
+
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{ID: 1})
+	require.NoError(t, err)
+	require.True(t, client.Handle(data))
+
+	// Token expiration not passed to library so client not asked to refresh
+	// subscription and connection not closed upon expiration.
+	exp := time.Now().Unix() + 1
+	reply, err := h.OnSubscribe(client, centrifuge.SubscribeEvent{
+		Channel: "$test",
+		Token:   getSubscribeTokenHS("$test", client.ID(), exp),
+	}, nil)
+	require.NoError(t, err)
+	require.Zero(t, reply.Options.ExpireAt)
+
+	require.True(t, client.Handle(subscribeCommand(t, 2, "$test", getSubscribeTokenHS("$test", client.ID(), exp))))
+	require.True(t, client.Handle(subscribeCommand(t, 3, "$other", getSubscribeTokenHS("$other", client.ID(), time.Now().Unix()+3600))))
+
+	channels := client.Channels()
+	sort.Strings(channels)
+	require.Equal(t, []string{"$other", "$test"}, channels)
+
+	deadline := time.Now().Add(3 * time.Second)
+	for len(client.Channels()) > 1 && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+	}
+	require.Equal(t, []string{"$other"}, client.Channels())
+	require.True(t, time.Now().Unix() >= exp)
+
+	select {
+	case <-transport.closeCh:
+		t.Fatal("connection must stay alive")
+	default:
+	}
+}
+
+func TestClientExpireUnsubscribeDisabled(t *testing.T) {
+	node := nodeWithMemoryEngineNoHandlers()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientInsecure = true
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{
+		HMACSecretKey: "secret",
+	}), proxy.Config{})
+
+	client, closeFn, err := centrifuge.NewClient(context.Background(), node, newTestTransport())
+	require.NoError(t, err)
+	defer func() { _ = closeFn() }()
+
+	exp := time.Now().Unix() + 10
+	reply, subExpireAt, err := h.onSubscribe(client, centrifuge.SubscribeEvent{
+		Channel: "$test",
+		Token:   getSubscribeTokenHS("$test", client.ID(), exp),
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, exp, reply.Options.ExpireAt)
+	require.Zero(t, subExpireAt)
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// TimeRPCMethod is a name of RPC method which returns current server time
+// when server time enabled for clients.
+const TimeRPCMethod = "time"
+
+func serverTimeMillis() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// timeRPC returns current server time in milliseconds so client SDK can
+// calculate clock offset.
+func (h *Handler) timeRPC(_ *centrifuge.Client, _ centrifuge.RPCEvent) (centrifuge.RPCReply, error) {
+	return centrifuge.RPCReply{
+		Data: []byte(`{"time":` + strconv.FormatInt(serverTimeMillis(), 10) + `}`),
+	}, nil
+}
+
+// withServerTime adds server time in milliseconds to JSON connect reply data.
+func withServerTime(data []byte, now int64) []byte {
+	return withJSONField(data, "time", strconv.FormatInt(now, 10))
+}
+
+// withJSONField prepends key with encoded JSON value to JSON connect reply
+// data. Empty data becomes an object with single field, field prepended to
+// JSON object data. Any other data, invalid JSON object or object which
+// already has key returned untouched – so connect data set by application
+// is never shadowed by duplicate key.
+func withJSONField(data []byte, key string, value string) []byte {
+	field := strconv.Quote(key) + ":" + value
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return []byte(`{` + field + `}`)
+	}
+	if trimmed[0] != '{' {
+		return data
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &fields); err != nil {
+		return data
+	}
+	if _, ok := fields[key]; ok {
+		return data
+	}
+	if len(fields) == 0 {
+		return []byte(`{` + field + `}`)
+	}
+	rest := bytes.TrimSpace(trimmed[1:])
+	result := make([]byte, 0, len(trimmed)+len(field)+1)
+	result = append(result, '{')
+	result = append(result, field...)
+	result = append(result, ',')
+	return append(result, rest...)
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/centrifugal/centrifugo/internal/jwtverify"
+	"github.com/centrifugal/centrifugo/internal/proxy"
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithServerTime(t *testing.T) {
+	require.Equal(t, `{"time":1}`, string(withServerTime(nil, 1)))
+	require.Equal(t, `{"time":1}`, string(withServerTime([]byte(`{}`), 1)))
+	require.Equal(t, `{"time":1,"a":"b"}`, string(withServerTime([]byte(`{"a":"b"}`), 1)))
+	require.Equal(t, `["a"]`, string(withServerTime([]byte(`["a"]`), 1)))
+	require.Equal(t, `{"time":"app","a":"b"}`, string(withServerTime([]byte(`{"time":"app","a":"b"}`), 1)))
+	require.Equal(t, `{"a":`, string(withServerTime([]byte(`{"a":`), 1)))
+}
+
+func TestClientConnectingServerTime(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientInsecure = true
+	ruleConfig.ClientServerTime = true
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+
+	before := serverTimeMillis()
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+		Transport: newTestTransport(),
+	}, nil, false)
+	require.NoError(t, err)
+	after := serverTimeMillis()
+
+	var data struct {
+		Time int64 `json:"time"`
+	}
+	require.NoError(t, json.Unmarshal(reply.Data, &data))
+	require.True(t, data.Time >= before && data.Time <= after)
+}
+
+func TestClientConnectingNoServerTime(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientInsecure = true
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+
+	reply, err := h.OnClientConnecting(context.Background(), centrifuge.ConnectEvent{
+		Transport: newTestTransport(),
+	}, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, reply.Data)
+}
+
+func TestClientTimeRPC(t *testing.T) {
+	node := nodeWithMemoryEngine()
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ClientServerTime = true
+	ruleContainer := rule.NewContainer(ruleConfig)
+	h := NewHandler(node, ruleContainer, jwtverify.NewTokenVerifierJWT(jwtverify.VerifierConfig{}), proxy.Config{})
+	h.Setup()
+
+	before := serverTimeMillis()
+	reply, err := h.OnRPC(&centrifuge.Client{}, centrifuge.RPCEvent{Method: TimeRPCMethod}, nil)
+	require.NoError(t, err)
+	after := serverTimeMillis()
+
+	var data struct {
+		Time int64 `json:"time"`
+	}
+	require.NoError(t, json.Unmarshal(reply.Data, &data))
+	require.True(t, data.Time >= before && data.Time <= after)
+}
@@ -0,0 +1,78 @@
+// Package configdir loads channel namespaces from configuration fragments
+// kept in directory so namespaces of different teams can live in separate
+// files.
+package configdir
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/FZambia/viper-lite"
+)
+
+// Merge appends namespaces defined in configuration fragments of dir to
+// namespaces defined in configuration file source. Fragment is a file with
+// one of viper.SupportedExts extensions which contains only namespaces key,
+// fragments loaded in lexical order of file names. Namespace defined in
+// several files is an error which names both files.
+func Merge(source string, namespaces []rule.ChannelNamespace, dir string) ([]rule.ChannelNamespace, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config dir: %w", err)
+	}
+	sources := make(map[string]string, len(namespaces))
+	for _, ns := range namespaces {
+		sources[ns.Name] = source
+	}
+	merged := append([]rule.ChannelNamespace(nil), namespaces...)
+	for _, file := range files {
+		if file.IsDir() || !supported(file.Name()) {
+			continue
+		}
+		path := filepath.Join(dir, file.Name())
+		fragment, err := readFragment(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range fragment {
+			if other, ok := sources[ns.Name]; ok {
+				return nil, fmt.Errorf("namespace %s defined in both %s and %s", ns.Name, other, path)
+			}
+			sources[ns.Name] = path
+			merged = append(merged, ns)
+		}
+	}
+	return merged, nil
+}
+
+func supported(name string) bool {
+	ext := strings.TrimPrefix(filepath.Ext(name), ".")
+	for _, supportedExt := range viper.SupportedExts {
+		if ext == supportedExt {
+			return true
+		}
+	}
+	return false
+}
+
+func readFragment(path string) ([]rule.ChannelNamespace, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("error reading config fragment %s: %w", path, err)
+	}
+	for _, key := range v.AllKeys() {
+		if key != "namespaces" {
+			return nil, fmt.Errorf("config fragment %s: unexpected option %s, only namespaces allowed", path, key)
+		}
+	}
+	var namespaces []rule.ChannelNamespace
+	if err := v.UnmarshalKey("namespaces", &namespaces); err != nil {
+		return nil, fmt.Errorf("config fragment %s: malformed namespaces: %w", path, err)
+	}
+	return namespaces, nil
+}
@@ -0,0 +1,75 @@
+package configdir
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestMerge(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrifugo_configdir")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	writeFile(t, dir, "chat.json", `{"namespaces": [{"name": "chat", "presence": true}]}`)
+	writeFile(t, dir, "feed.yaml", "namespaces:\n  - name: feed\n    history_size: 10\n    history_lifetime: 60\n")
+	writeFile(t, dir, "notes.txt", "not a config")
+
+	namespaces, err := Merge("config.json", []rule.ChannelNamespace{{Name: "main"}}, dir)
+	require.NoError(t, err)
+	require.Len(t, namespaces, 3)
+	require.Equal(t, "main", namespaces[0].Name)
+	require.Equal(t, "chat", namespaces[1].Name)
+	require.True(t, namespaces[1].Presence)
+	require.Equal(t, "feed", namespaces[2].Name)
+	require.Equal(t, 10, namespaces[2].HistorySize)
+
+	c := rule.DefaultConfig
+	c.Namespaces = namespaces
+	require.NoError(t, c.Validate())
+}
+
+func TestMergeDuplicateNamespace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrifugo_configdir")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	first := writeFile(t, dir, "a.json", `{"namespaces": [{"name": "chat"}]}`)
+	second := writeFile(t, dir, "b.toml", "[[namespaces]]\nname = \"chat\"\n")
+
+	_, err = Merge("config.json", nil, dir)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), first)
+	require.Contains(t, err.Error(), second)
+
+	_, err = Merge("config.json", []rule.ChannelNamespace{{Name: "chat"}}, dir)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "config.json")
+	require.Contains(t, err.Error(), first)
+}
+
+func TestMergeUnexpectedOption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrifugo_configdir")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	writeFile(t, dir, "a.json", `{"namespaces": [], "api_key": "secret"}`)
+	_, err = Merge("config.json", nil, dir)
+	require.Error(t, err)
+}
+
+func TestMergeNoDir(t *testing.T) {
+	_, err := Merge("config.json", nil, "/nonexistent_centrifugo_configdir")
+	require.Error(t, err)
+}
@@ -0,0 +1,148 @@
+// Package configdump builds report of effective node configuration with
+// secret values redacted.
+package configdump
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Redacted replaces non-empty secret values in Dump.
+const Redacted = "<redacted>"
+
+// Dump of effective configuration.
+type Dump struct {
+	// Options are values of configuration options after applying defaults,
+	// configuration file, environment variables and command-line flags.
+	Options map[string]interface{} `json:"options"`
+	// Derived are values computed from options – for example node name
+	// generated when name option not set.
+	Derived map[string]interface{} `json:"derived"`
+}
+
+// New creates Dump. String values of options which names contain secret or
+// password and of api key options are redacted at any nesting level, paths
+// to files with secrets kept. Userinfo of URL values (for example password
+// in redis_url) is redacted for all options.
+func New(options map[string]interface{}, derived map[string]interface{}) Dump {
+	return Dump{
+		Options: redactMap(options),
+		Derived: redactMap(derived),
+	}
+}
+
+// JSON returns indented JSON representation of Dump.
+func (d Dump) JSON() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(d); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func isSecret(key string) bool {
+	key = strings.ToLower(key)
+	if strings.HasSuffix(key, "_file") {
+		return false
+	}
+	return strings.Contains(key, "secret") || strings.Contains(key, "password") || strings.HasSuffix(key, "api_key")
+}
+
+func redactMap(m map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if isSecret(k) {
+			result[k] = redactValue(v)
+			continue
+		}
+		result[k] = normalize(v)
+	}
+	return result
+}
+
+// redactValue keeps empty values as is so it's visible whether secret set.
+// Non-string values like boolean flags are not secrets.
+func redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case string:
+		if val == "" {
+			return val
+		}
+		return Redacted
+	case []string:
+		redacted := make([]string, len(val))
+		for i := range val {
+			redacted[i] = Redacted
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(val))
+		for i := range val {
+			redacted[i] = Redacted
+		}
+		return redacted
+	}
+	return v
+}
+
+// normalize converts nested values parsed from configuration file (YAML
+// decoder produces maps with interface{} keys) to JSON compatible form
+// redacting secrets on the way.
+func normalize(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return redactMap(val)
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(val))
+		for k, v := range val {
+			m[fmt.Sprint(k)] = v
+		}
+		return redactMap(m)
+	case []interface{}:
+		result := make([]interface{}, len(val))
+		for i, item := range val {
+			result[i] = normalize(item)
+		}
+		return result
+	case []string:
+		result := make([]string, len(val))
+		for i, item := range val {
+			result[i] = redactUserinfo(item)
+		}
+		return result
+	case string:
+		return redactUserinfo(val)
+	}
+	return v
+}
+
+// redactUserinfo redacts user:password@ part of URLs in value. Value can be
+// a comma-separated list of URLs like nats_url.
+func redactUserinfo(v string) string {
+	if !strings.Contains(v, "://") {
+		return v
+	}
+	parts := strings.Split(v, ",")
+	for i, part := range parts {
+		schemeEnd := strings.Index(part, "://")
+		if schemeEnd < 0 {
+			continue
+		}
+		rest := part[schemeEnd+3:]
+		authority := rest
+		if end := strings.IndexAny(rest, "/?#"); end >= 0 {
+			authority = rest[:end]
+		}
+		at := strings.LastIndex(authority, "@")
+		if at < 0 {
+			continue
+		}
+		parts[i] = part[:schemeEnd+3] + Redacted + rest[at:]
+	}
+	return strings.Join(parts, ",")
+}
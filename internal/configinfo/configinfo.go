@@ -0,0 +1,86 @@
+// Package configinfo exposes effective configuration values as Prometheus
+// metric so configuration drift between nodes can be detected.
+package configinfo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var metricsNamespace = "centrifugo"
+
+var configInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: metricsNamespace,
+	Subsystem: "config",
+	Name:      "info",
+	Help:      "Effective configuration option values of node, metric value is always 1.",
+}, []string{"option", "value"})
+
+func init() {
+	prometheus.MustRegister(configInfo)
+}
+
+// Keys is a list of configuration options exposed over metric. It only
+// contains options without secrets – never add secret options here.
+var Keys = []string{
+	"engine",
+	"broker",
+	"v3_use_offset",
+	"presence",
+	"join_leave",
+	"history_size",
+	"history_lifetime",
+	"history_recover",
+	"client_anonymous",
+	"client_concurrency",
+	"client_channel_limit",
+	"client_queue_max_size",
+	"client_request_max_size",
+	"client_user_connection_limit",
+	"client_expired_close_delay",
+	"client_expired_sub_close_delay",
+	"client_stale_close_delay",
+	"client_presence_ping_interval",
+	"client_presence_expire_interval",
+	"client_ping_interval",
+	"client_message_write_timeout",
+	"channel_max_length",
+	"broadcast_workers",
+	"presence_max_size",
+	"presence_eviction_policy",
+	"join_leave_batch_interval",
+	"publish_data_validation",
+	"engine_publish_failure_policy",
+	"engine_slow_log_threshold",
+	"redis_pubsub_num_workers",
+	"node_info_metrics_aggregate_interval",
+	"shutdown_timeout",
+	"tls_min_version",
+}
+
+// Values returns string values of Keys options using provided getter.
+func Values(get func(key string) interface{}) map[string]string {
+	values := make(map[string]string, len(Keys))
+	for _, key := range Keys {
+		v := get(key)
+		if v == nil {
+			continue
+		}
+		if s, ok := v.([]string); ok {
+			values[key] = strings.Join(s, ",")
+			continue
+		}
+		values[key] = fmt.Sprint(v)
+	}
+	return values
+}
+
+// Set replaces exposed configuration values.
+func Set(values map[string]string) {
+	configInfo.Reset()
+	for option, value := range values {
+		configInfo.WithLabelValues(option, value).Set(1)
+	}
+}
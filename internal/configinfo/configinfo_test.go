@@ -0,0 +1,56 @@
+package configinfo
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func gatherConfigInfo(t *testing.T) map[string]string {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	values := make(map[string]string)
+	for _, family := range families {
+		if family.GetName() != "centrifugo_config_info" {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range m.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			require.Equal(t, float64(1), m.GetGauge().GetValue())
+			values[labels["option"]] = labels["value"]
+		}
+	}
+	return values
+}
+
+func TestConfigInfo(t *testing.T) {
+	options := map[string]interface{}{
+		"engine":                        "redis",
+		"client_channel_limit":          128,
+		"client_presence_ping_interval": 25,
+		"api_key":                       "secret",
+	}
+	get := func(key string) interface{} {
+		return options[key]
+	}
+
+	Set(Values(get))
+	values := gatherConfigInfo(t)
+	require.Equal(t, "redis", values["engine"])
+	require.Equal(t, "128", values["client_channel_limit"])
+	require.Equal(t, "25", values["client_presence_ping_interval"])
+	require.NotContains(t, values, "api_key")
+	require.NotContains(t, values, "broker")
+
+	// Values replaced on reload.
+	options["engine"] = "memory"
+	Set(Values(get))
+	values = gatherConfigInfo(t)
+	require.Equal(t, "memory", values["engine"])
+	require.Equal(t, len(values), testutil.CollectAndCount(configInfo))
+}
@@ -0,0 +1,144 @@
+// Package controlmsg defines Broker which makes node tolerant to control
+// messages of methods it does not know about.
+package controlmsg
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// UnknownPolicy describes behavior on receiving control message with unknown
+// method.
+type UnknownPolicy string
+
+const (
+	// UnknownIgnore means message logged on debug level and ignored.
+	UnknownIgnore UnknownPolicy = "ignore"
+	// UnknownError means message handled by node as usual which results
+	// into error.
+	UnknownError UnknownPolicy = "error"
+)
+
+// Config of Broker.
+type Config struct {
+	// UnknownPolicy sets what to do with control message of method current
+	// node does not know (sent by node of newer version). By default such
+	// messages ignored.
+	UnknownPolicy UnknownPolicy
+}
+
+// Validate ...
+func (c Config) Validate() error {
+	switch c.UnknownPolicy {
+	case "", UnknownIgnore, UnknownError:
+		return nil
+	default:
+		return fmt.Errorf("unknown policy for unknown control messages: %s", c.UnknownPolicy)
+	}
+}
+
+// Control message methods known by centrifuge library.
+const (
+	methodNode        = 0
+	methodUnsubscribe = 1
+	methodDisconnect  = 2
+)
+
+// methodField is a number of method field in control command. Command
+// encoded with Protobuf and has method (type) field as any control message
+// since first version of format, so node can tell messages of newer nodes
+// without decoding their params.
+const methodField = 2
+
+// Broker wraps centrifuge.Broker and filters control messages passed to
+// node according to UnknownPolicy.
+type Broker struct {
+	centrifuge.Broker
+	node   *centrifuge.Node
+	config Config
+}
+
+var _ centrifuge.Broker = (*Broker)(nil)
+
+// New creates Broker.
+func New(n *centrifuge.Node, broker centrifuge.Broker, c Config) *Broker {
+	return &Broker{
+		Broker: broker,
+		node:   n,
+		config: c,
+	}
+}
+
+// Run ...
+func (b *Broker) Run(h centrifuge.BrokerEventHandler) error {
+	return b.Broker.Run(&eventHandler{BrokerEventHandler: h, broker: b})
+}
+
+type eventHandler struct {
+	centrifuge.BrokerEventHandler
+	broker *Broker
+}
+
+// HandleControl ...
+func (h *eventHandler) HandleControl(data []byte) error {
+	if h.broker.config.UnknownPolicy == UnknownError {
+		return h.BrokerEventHandler.HandleControl(data)
+	}
+	method, ok := decodeMethod(data)
+	if ok && !knownMethod(method) {
+		h.broker.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelDebug, "unknown control message method ignored", map[string]interface{}{"method": method}))
+		return nil
+	}
+	// Malformed messages passed to node to report decoding error.
+	return h.BrokerEventHandler.HandleControl(data)
+}
+
+func knownMethod(method uint64) bool {
+	switch method {
+	case methodNode, methodUnsubscribe, methodDisconnect:
+		return true
+	default:
+		return false
+	}
+}
+
+// Protobuf wire types control command fields encoded with.
+const (
+	wireVarint = 0
+	wireBytes  = 2
+)
+
+// decodeMethod extracts method of control command skipping other fields.
+func decodeMethod(data []byte) (uint64, bool) {
+	var method uint64
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, false
+		}
+		data = data[n:]
+		field, wireType := key>>3, key&0x7
+		switch wireType {
+		case wireVarint:
+			v, n := binary.Uvarint(data)
+			if n <= 0 {
+				return 0, false
+			}
+			data = data[n:]
+			if field == methodField {
+				method = v
+			}
+		case wireBytes:
+			l, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < l {
+				return 0, false
+			}
+			data = data[n+int(l):]
+		default:
+			return 0, false
+		}
+	}
+	return method, true
+}
@@ -0,0 +1,99 @@
+package controlmsg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingEngine remembers event handler engine was run with.
+type recordingEngine struct {
+	centrifuge.Engine
+	handler centrifuge.BrokerEventHandler
+}
+
+func (e *recordingEngine) Run(h centrifuge.BrokerEventHandler) error {
+	e.handler = h
+	return e.Engine.Run(h)
+}
+
+func encodeCommand(t *testing.T, uid string, method uint64, params []byte) []byte {
+	buf := proto.NewBuffer(nil)
+	require.NoError(t, buf.EncodeVarint(1<<3|wireBytes))
+	require.NoError(t, buf.EncodeStringBytes(uid))
+	if method > 0 {
+		require.NoError(t, buf.EncodeVarint(methodField<<3|wireVarint))
+		require.NoError(t, buf.EncodeVarint(method))
+	}
+	require.NoError(t, buf.EncodeVarint(3<<3|wireBytes))
+	require.NoError(t, buf.EncodeRawBytes(params))
+	return buf.Bytes()
+}
+
+func encodeNode(t *testing.T, uid string) []byte {
+	buf := proto.NewBuffer(nil)
+	require.NoError(t, buf.EncodeVarint(1<<3|wireBytes))
+	require.NoError(t, buf.EncodeStringBytes(uid))
+	require.NoError(t, buf.EncodeVarint(2<<3|wireBytes))
+	require.NoError(t, buf.EncodeStringBytes("other"))
+	return buf.Bytes()
+}
+
+func newTestNode(t *testing.T, policy UnknownPolicy) (*centrifuge.Node, *recordingEngine) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	memoryEngine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	e := &recordingEngine{Engine: memoryEngine}
+	node.SetEngine(memoryEngine)
+	node.SetBroker(New(node, e, Config{UnknownPolicy: policy}))
+	require.NoError(t, node.Run())
+	return node, e
+}
+
+func TestDecodeMethod(t *testing.T) {
+	method, ok := decodeMethod(encodeCommand(t, "uid", 10, []byte("params")))
+	require.True(t, ok)
+	require.Equal(t, uint64(10), method)
+
+	// Method with zero value omitted in Protobuf.
+	method, ok = decodeMethod(encodeCommand(t, "uid", methodNode, nil))
+	require.True(t, ok)
+	require.Equal(t, uint64(methodNode), method)
+
+	_, ok = decodeMethod([]byte{0x0a, 0x10, 'a'})
+	require.False(t, ok)
+}
+
+func TestUnknownControlIgnored(t *testing.T) {
+	node, e := newTestNode(t, "")
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	require.NoError(t, e.handler.HandleControl(encodeCommand(t, "other", 10, []byte("future"))))
+
+	// Known messages still handled.
+	require.NoError(t, e.handler.HandleControl(encodeCommand(t, "other", methodNode, encodeNode(t, "other"))))
+	info, err := node.Info()
+	require.NoError(t, err)
+	require.Len(t, info.Nodes, 2)
+
+	// Malformed messages still reported.
+	require.Error(t, e.handler.HandleControl([]byte{0x0a, 0x10, 'a'}))
+}
+
+func TestUnknownControlError(t *testing.T) {
+	node, e := newTestNode(t, UnknownError)
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	require.Error(t, e.handler.HandleControl(encodeCommand(t, "other", 10, []byte("future"))))
+	require.NoError(t, e.handler.HandleControl(encodeCommand(t, "other", methodNode, encodeNode(t, "other"))))
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, Config{}.Validate())
+	require.NoError(t, Config{UnknownPolicy: UnknownError}.Validate())
+	require.Error(t, Config{UnknownPolicy: "unknown"}.Validate())
+}
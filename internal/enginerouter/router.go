@@ -0,0 +1,215 @@
+// Package enginerouter dispatches channel operations to different engines
+// according to channel prefix.
+package enginerouter
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// Route maps channels starting with Prefix to Engine.
+type Route struct {
+	Prefix string
+	Engine centrifuge.Engine
+}
+
+// Router is centrifuge.Engine which handles channel with engine of route
+// with the longest matching prefix, channels not matching any route handled
+// by default engine. Control messages always sent over default engine.
+type Router struct {
+	defaultEngine centrifuge.Engine
+	routes        []Route
+}
+
+var _ centrifuge.Engine = (*Router)(nil)
+
+// New creates Router.
+func New(defaultEngine centrifuge.Engine, routes []Route) *Router {
+	sorted := make([]Route, len(routes))
+	copy(sorted, routes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].Prefix) > len(sorted[j].Prefix)
+	})
+	return &Router{
+		defaultEngine: defaultEngine,
+		routes:        sorted,
+	}
+}
+
+func (r *Router) engine(ch string) centrifuge.Engine {
+	for _, route := range r.routes {
+		if strings.HasPrefix(ch, route.Prefix) {
+			return route.Engine
+		}
+	}
+	return r.defaultEngine
+}
+
+// engines returns distinct engines of Router, default engine goes first.
+func (r *Router) engines() []centrifuge.Engine {
+	engines := []centrifuge.Engine{r.defaultEngine}
+	for _, route := range r.routes {
+		seen := false
+		for _, e := range engines {
+			if e == route.Engine {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			engines = append(engines, route.Engine)
+		}
+	}
+	return engines
+}
+
+// routedHandler passes to node only events of channels routed to engine so
+// engines never deliver messages of channels they do not own.
+type routedHandler struct {
+	router  *Router
+	engine  centrifuge.Engine
+	handler centrifuge.BrokerEventHandler
+}
+
+func (h *routedHandler) owns(ch string) bool {
+	return h.router.engine(ch) == h.engine
+}
+
+// HandlePublication ...
+func (h *routedHandler) HandlePublication(ch string, pub *centrifuge.Publication) error {
+	if !h.owns(ch) {
+		return nil
+	}
+	return h.handler.HandlePublication(ch, pub)
+}
+
+// HandleJoin ...
+func (h *routedHandler) HandleJoin(ch string, info *centrifuge.ClientInfo) error {
+	if !h.owns(ch) {
+		return nil
+	}
+	return h.handler.HandleJoin(ch, info)
+}
+
+// HandleLeave ...
+func (h *routedHandler) HandleLeave(ch string, info *centrifuge.ClientInfo) error {
+	if !h.owns(ch) {
+		return nil
+	}
+	return h.handler.HandleLeave(ch, info)
+}
+
+// HandleControl ...
+func (h *routedHandler) HandleControl(data []byte) error {
+	if h.engine != h.router.defaultEngine {
+		return nil
+	}
+	return h.handler.HandleControl(data)
+}
+
+// Run runs all engines.
+func (r *Router) Run(h centrifuge.BrokerEventHandler) error {
+	for _, e := range r.engines() {
+		if err := e.Run(&routedHandler{router: r, engine: e, handler: h}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Subscribe ...
+func (r *Router) Subscribe(ch string) error {
+	return r.engine(ch).Subscribe(ch)
+}
+
+// Unsubscribe ...
+func (r *Router) Unsubscribe(ch string) error {
+	return r.engine(ch).Unsubscribe(ch)
+}
+
+// Publish ...
+func (r *Router) Publish(ch string, data []byte, opts centrifuge.PublishOptions) (centrifuge.StreamPosition, error) {
+	return r.engine(ch).Publish(ch, data, opts)
+}
+
+// PublishJoin ...
+func (r *Router) PublishJoin(ch string, info *centrifuge.ClientInfo) error {
+	return r.engine(ch).PublishJoin(ch, info)
+}
+
+// PublishLeave ...
+func (r *Router) PublishLeave(ch string, info *centrifuge.ClientInfo) error {
+	return r.engine(ch).PublishLeave(ch, info)
+}
+
+// PublishControl ...
+func (r *Router) PublishControl(data []byte) error {
+	return r.defaultEngine.PublishControl(data)
+}
+
+// History ...
+func (r *Router) History(ch string, filter centrifuge.HistoryFilter) ([]*centrifuge.Publication, centrifuge.StreamPosition, error) {
+	return r.engine(ch).History(ch, filter)
+}
+
+// RemoveHistory ...
+func (r *Router) RemoveHistory(ch string) error {
+	return r.engine(ch).RemoveHistory(ch)
+}
+
+// Channels returns active channels of all engines.
+func (r *Router) Channels() ([]string, error) {
+	var channels []string
+	seen := map[string]struct{}{}
+	for _, e := range r.engines() {
+		engineChannels, err := e.Channels()
+		if err != nil {
+			return nil, err
+		}
+		for _, ch := range engineChannels {
+			if _, ok := seen[ch]; ok {
+				continue
+			}
+			seen[ch] = struct{}{}
+			channels = append(channels, ch)
+		}
+	}
+	return channels, nil
+}
+
+// Presence ...
+func (r *Router) Presence(ch string) (map[string]*centrifuge.ClientInfo, error) {
+	return r.engine(ch).Presence(ch)
+}
+
+// PresenceStats ...
+func (r *Router) PresenceStats(ch string) (centrifuge.PresenceStats, error) {
+	return r.engine(ch).PresenceStats(ch)
+}
+
+// AddPresence ...
+func (r *Router) AddPresence(ch string, clientID string, info *centrifuge.ClientInfo, expire time.Duration) error {
+	return r.engine(ch).AddPresence(ch, clientID, info, expire)
+}
+
+// RemovePresence ...
+func (r *Router) RemovePresence(ch string, clientID string) error {
+	return r.engine(ch).RemovePresence(ch, clientID)
+}
+
+// Close closes engines which support closing.
+func (r *Router) Close(ctx context.Context) error {
+	var firstErr error
+	for _, e := range r.engines() {
+		if closer, ok := e.(centrifuge.Closer); ok {
+			if err := closer.Close(ctx); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,152 @@
+package enginerouter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingEngine remembers event handler engine was run with.
+type recordingEngine struct {
+	centrifuge.Engine
+	handler centrifuge.BrokerEventHandler
+}
+
+func (e *recordingEngine) Run(h centrifuge.BrokerEventHandler) error {
+	e.handler = h
+	return e.Engine.Run(h)
+}
+
+type testEventHandler struct {
+	publications []string
+	controls     int
+}
+
+func (h *testEventHandler) HandlePublication(ch string, _ *centrifuge.Publication) error {
+	h.publications = append(h.publications, ch)
+	return nil
+}
+
+func (h *testEventHandler) HandleJoin(_ string, _ *centrifuge.ClientInfo) error {
+	return nil
+}
+
+func (h *testEventHandler) HandleLeave(_ string, _ *centrifuge.ClientInfo) error {
+	return nil
+}
+
+func (h *testEventHandler) HandleControl(_ []byte) error {
+	h.controls++
+	return nil
+}
+
+func newMemoryEngine(t *testing.T, node *centrifuge.Node) *recordingEngine {
+	e, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	return &recordingEngine{Engine: e}
+}
+
+func TestRouterEngine(t *testing.T) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	defaultEngine := newMemoryEngine(t, node)
+	ephemeral := newMemoryEngine(t, node)
+	durable := newMemoryEngine(t, node)
+	r := New(defaultEngine, []Route{
+		{Prefix: "events:", Engine: ephemeral},
+		{Prefix: "events:durable:", Engine: durable},
+		{Prefix: "orders:", Engine: durable},
+	})
+	require.Equal(t, centrifuge.Engine(ephemeral), r.engine("events:chat"))
+	require.Equal(t, centrifuge.Engine(durable), r.engine("events:durable:chat"))
+	require.Equal(t, centrifuge.Engine(durable), r.engine("orders:1"))
+	require.Equal(t, centrifuge.Engine(defaultEngine), r.engine("chat"))
+	require.Equal(t, centrifuge.Engine(defaultEngine), r.engine("event"))
+	require.Len(t, r.engines(), 3)
+}
+
+func TestRouterHistory(t *testing.T) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	defaultEngine := newMemoryEngine(t, node)
+	other := newMemoryEngine(t, node)
+	r := New(defaultEngine, []Route{{Prefix: "other:", Engine: other}})
+	node.SetEngine(r)
+	require.NoError(t, node.Run())
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	for _, ch := range []string{"chat", "other:chat"} {
+		_, err := node.Publish(ch, []byte(`{}`), centrifuge.WithHistory(10, time.Minute))
+		require.NoError(t, err)
+	}
+
+	pubs, _, err := defaultEngine.History("chat", centrifuge.HistoryFilter{Limit: -1})
+	require.NoError(t, err)
+	require.Len(t, pubs, 1)
+	pubs, _, err = defaultEngine.History("other:chat", centrifuge.HistoryFilter{Limit: -1})
+	require.NoError(t, err)
+	require.Len(t, pubs, 0)
+	pubs, _, err = other.History("other:chat", centrifuge.HistoryFilter{Limit: -1})
+	require.NoError(t, err)
+	require.Len(t, pubs, 1)
+	pubs, _, err = other.History("chat", centrifuge.HistoryFilter{Limit: -1})
+	require.NoError(t, err)
+	require.Len(t, pubs, 0)
+
+	history, err := node.History("other:chat", centrifuge.WithLimit(centrifuge.NoLimit))
+	require.NoError(t, err)
+	require.Len(t, history.Publications, 1)
+}
+
+func TestRouterPresence(t *testing.T) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	defaultEngine := newMemoryEngine(t, node)
+	other := newMemoryEngine(t, node)
+	r := New(defaultEngine, []Route{{Prefix: "other:", Engine: other}})
+
+	require.NoError(t, r.AddPresence("other:chat", "client", &centrifuge.ClientInfo{ClientID: "client"}, time.Minute))
+	presence, err := other.Presence("other:chat")
+	require.NoError(t, err)
+	require.Len(t, presence, 1)
+	presence, err = defaultEngine.Presence("other:chat")
+	require.NoError(t, err)
+	require.Len(t, presence, 0)
+	stats, err := r.PresenceStats("other:chat")
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.NumClients)
+	require.NoError(t, r.RemovePresence("other:chat", "client"))
+	presence, err = r.Presence("other:chat")
+	require.NoError(t, err)
+	require.Len(t, presence, 0)
+}
+
+func TestRouterNoCrossDelivery(t *testing.T) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	defaultEngine := newMemoryEngine(t, node)
+	other := newMemoryEngine(t, node)
+	r := New(defaultEngine, []Route{{Prefix: "other:", Engine: other}})
+	h := &testEventHandler{}
+	require.NoError(t, r.Run(h))
+
+	_, err = r.Publish("chat", []byte(`{}`), centrifuge.PublishOptions{})
+	require.NoError(t, err)
+	_, err = r.Publish("other:chat", []byte(`{}`), centrifuge.PublishOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"chat", "other:chat"}, h.publications)
+
+	// Events of channels engine does not own dropped.
+	require.NoError(t, other.handler.HandlePublication("chat", &centrifuge.Publication{}))
+	require.NoError(t, defaultEngine.handler.HandlePublication("other:chat", &centrifuge.Publication{}))
+	require.Equal(t, []string{"chat", "other:chat"}, h.publications)
+
+	// Control only from default engine.
+	require.NoError(t, r.PublishControl([]byte(`{}`)))
+	require.Equal(t, 1, h.controls)
+	require.NoError(t, other.handler.HandleControl([]byte(`{}`)))
+	require.Equal(t, 1, h.controls)
+}
@@ -0,0 +1,116 @@
+package enginestats
+
+import (
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// Broker wraps centrifuge.Broker and measures its operations.
+type Broker struct {
+	centrifuge.Broker
+	stats *Stats
+}
+
+var _ centrifuge.Broker = (*Broker)(nil)
+
+// NewBroker creates Broker.
+func NewBroker(broker centrifuge.Broker, stats *Stats) *Broker {
+	return &Broker{
+		Broker: broker,
+		stats:  stats,
+	}
+}
+
+// Publish ...
+func (b *Broker) Publish(ch string, data []byte, opts centrifuge.PublishOptions) (centrifuge.StreamPosition, error) {
+	operation := OperationPublish
+	if opts.HistorySize > 0 && opts.HistoryTTL > 0 {
+		operation = OperationHistoryAdd
+	}
+	started := time.Now()
+	sp, err := b.Broker.Publish(ch, data, opts)
+	b.stats.observe(operation, ch, started, err)
+	return sp, err
+}
+
+// Subscribe ...
+func (b *Broker) Subscribe(ch string) error {
+	started := time.Now()
+	err := b.Broker.Subscribe(ch)
+	b.stats.observe(OperationSubscribe, ch, started, err)
+	return err
+}
+
+// Unsubscribe ...
+func (b *Broker) Unsubscribe(ch string) error {
+	started := time.Now()
+	err := b.Broker.Unsubscribe(ch)
+	b.stats.observe(OperationUnsubscribe, ch, started, err)
+	return err
+}
+
+// History ...
+func (b *Broker) History(ch string, filter centrifuge.HistoryFilter) ([]*centrifuge.Publication, centrifuge.StreamPosition, error) {
+	started := time.Now()
+	pubs, sp, err := b.Broker.History(ch, filter)
+	b.stats.observe(OperationHistoryGet, ch, started, err)
+	return pubs, sp, err
+}
+
+// RemoveHistory ...
+func (b *Broker) RemoveHistory(ch string) error {
+	started := time.Now()
+	err := b.Broker.RemoveHistory(ch)
+	b.stats.observe(OperationHistoryRemove, ch, started, err)
+	return err
+}
+
+// PresenceManager wraps centrifuge.PresenceManager and measures its
+// operations.
+type PresenceManager struct {
+	centrifuge.PresenceManager
+	stats *Stats
+}
+
+var _ centrifuge.PresenceManager = (*PresenceManager)(nil)
+
+// NewPresenceManager creates PresenceManager.
+func NewPresenceManager(pm centrifuge.PresenceManager, stats *Stats) *PresenceManager {
+	return &PresenceManager{
+		PresenceManager: pm,
+		stats:           stats,
+	}
+}
+
+// Presence ...
+func (m *PresenceManager) Presence(ch string) (map[string]*centrifuge.ClientInfo, error) {
+	started := time.Now()
+	presence, err := m.PresenceManager.Presence(ch)
+	m.stats.observe(OperationPresenceGet, ch, started, err)
+	return presence, err
+}
+
+// PresenceStats ...
+func (m *PresenceManager) PresenceStats(ch string) (centrifuge.PresenceStats, error) {
+	started := time.Now()
+	stats, err := m.PresenceManager.PresenceStats(ch)
+	m.stats.observe(OperationPresenceStatsGet, ch, started, err)
+	return stats, err
+}
+
+// AddPresence ...
+func (m *PresenceManager) AddPresence(ch string, clientID string, info *centrifuge.ClientInfo, expire time.Duration) error {
+	started := time.Now()
+	err := m.PresenceManager.AddPresence(ch, clientID, info, expire)
+	m.stats.observe(OperationPresenceAdd, ch, started, err)
+	return err
+}
+
+// RemovePresence ...
+func (m *PresenceManager) RemovePresence(ch string, clientID string) error {
+	started := time.Now()
+	err := m.PresenceManager.RemovePresence(ch, clientID)
+	m.stats.observe(OperationPresenceRemove, ch, started, err)
+	return err
+}
@@ -0,0 +1,152 @@
+// Package enginestats measures latency and errors of engine operations.
+package enginestats
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+var metricsNamespace = "centrifugo"
+
+var (
+	operationDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "engine",
+		Name:      "operation_duration_seconds",
+		Buckets:   prometheus.DefBuckets,
+		Help:      "Histogram of duration of engine operations.",
+	}, []string{"operation"})
+	operationErrorsCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "engine",
+		Name:      "operation_errors_total",
+		Help:      "Number of failed engine operations.",
+	}, []string{"operation"})
+)
+
+func init() {
+	prometheus.MustRegister(operationDurationHistogram)
+	prometheus.MustRegister(operationErrorsCount)
+}
+
+// Engine operations measured.
+const (
+	// OperationPublish is a publish into channel without history.
+	OperationPublish = "publish"
+	// OperationHistoryAdd is a publish into channel with history.
+	OperationHistoryAdd       = "history_add"
+	OperationSubscribe        = "subscribe"
+	OperationUnsubscribe      = "unsubscribe"
+	OperationHistoryGet       = "history_get"
+	OperationHistoryRemove    = "history_remove"
+	OperationPresenceGet      = "presence_get"
+	OperationPresenceStatsGet = "presence_stats_get"
+	OperationPresenceAdd      = "presence_add"
+	OperationPresenceRemove   = "presence_remove"
+)
+
+// recentSize is a number of latest samples per operation used to build
+// Summary.
+const recentSize = 1000
+
+type sample struct {
+	duration time.Duration
+	failed   bool
+}
+
+type window struct {
+	samples []sample
+	next    int
+}
+
+// Stats keeps latest engine operation samples in memory.
+type Stats struct {
+	mu         sync.Mutex
+	operations map[string]*window
+
+	slowThreshold time.Duration
+}
+
+// New creates Stats.
+func New() *Stats {
+	return &Stats{
+		operations: make(map[string]*window),
+	}
+}
+
+// SetSlowThreshold sets duration of engine operation above which warning
+// logged. Zero value disables logging slow operations. Must be called before
+// Stats used.
+func (s *Stats) SetSlowThreshold(threshold time.Duration) {
+	s.slowThreshold = threshold
+}
+
+func (s *Stats) observe(operation string, ch string, started time.Time, err error) {
+	duration := time.Since(started)
+	if s.slowThreshold > 0 && duration > s.slowThreshold {
+		log.Warn().Str("operation", operation).Str("channel", ch).Dur("duration", duration).Msg("slow engine operation")
+	}
+	operationDurationHistogram.WithLabelValues(operation).Observe(duration.Seconds())
+	if err != nil {
+		operationErrorsCount.WithLabelValues(operation).Inc()
+	}
+	s.add(operation, sample{duration: duration, failed: err != nil})
+}
+
+func (s *Stats) add(operation string, smp sample) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w, ok := s.operations[operation]
+	if !ok {
+		w = &window{}
+		s.operations[operation] = w
+	}
+	if len(w.samples) < recentSize {
+		w.samples = append(w.samples, smp)
+		return
+	}
+	w.samples[w.next] = smp
+	w.next = (w.next + 1) % recentSize
+}
+
+// OperationSummary describes latest samples of engine operation.
+type OperationSummary struct {
+	Operation string
+	// Count of samples summary built from.
+	Count int
+	// P99 latency of operation.
+	P99 time.Duration
+	// ErrorRate is a fraction of failed operations.
+	ErrorRate float64
+}
+
+// Summary returns summary of latest samples for every operation measured,
+// sorted by operation name.
+func (s *Stats) Summary() []OperationSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	summary := make([]OperationSummary, 0, len(s.operations))
+	for operation, w := range s.operations {
+		durations := make([]time.Duration, len(w.samples))
+		var numFailed int
+		for i, smp := range w.samples {
+			durations[i] = smp.duration
+			if smp.failed {
+				numFailed++
+			}
+		}
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		summary = append(summary, OperationSummary{
+			Operation: operation,
+			Count:     len(durations),
+			P99:       durations[(len(durations)*99-1)/100],
+			ErrorRate: float64(numFailed) / float64(len(durations)),
+		})
+	}
+	sort.Slice(summary, func(i, j int) bool { return summary[i].Operation < summary[j].Operation })
+	return summary
+}
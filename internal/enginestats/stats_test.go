@@ -0,0 +1,185 @@
+package enginestats
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+)
+
+func summaryByOperation(s *Stats) map[string]OperationSummary {
+	res := map[string]OperationSummary{}
+	for _, item := range s.Summary() {
+		res[item.Operation] = item
+	}
+	return res
+}
+
+func TestStatsSummary(t *testing.T) {
+	s := New()
+	require.Len(t, s.Summary(), 0)
+	for i := 1; i <= 100; i++ {
+		s.add("op", sample{duration: time.Duration(i) * time.Millisecond, failed: i%10 == 0})
+	}
+	s.add("a", sample{duration: time.Second})
+	summary := s.Summary()
+	require.Len(t, summary, 2)
+	require.Equal(t, OperationSummary{Operation: "a", Count: 1, P99: time.Second}, summary[0])
+	require.Equal(t, OperationSummary{Operation: "op", Count: 100, P99: 99 * time.Millisecond, ErrorRate: 0.1}, summary[1])
+}
+
+func TestStatsSummaryRecent(t *testing.T) {
+	s := New()
+	for i := 0; i < recentSize; i++ {
+		s.add("op", sample{duration: time.Second, failed: true})
+	}
+	// Old samples replaced by new ones.
+	for i := 0; i < recentSize; i++ {
+		s.add("op", sample{duration: time.Millisecond})
+	}
+	summary := s.Summary()
+	require.Len(t, summary, 1)
+	require.Equal(t, OperationSummary{Operation: "op", Count: recentSize, P99: time.Millisecond}, summary[0])
+}
+
+func TestEngineOperationsRecorded(t *testing.T) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	stats := New()
+	node.SetBroker(NewBroker(engine, stats))
+	node.SetPresenceManager(NewPresenceManager(engine, stats))
+	require.NoError(t, node.Run())
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	_, err = node.Publish("test", []byte(`{}`))
+	require.NoError(t, err)
+	_, err = node.Publish("test", []byte(`{}`), centrifuge.WithHistory(10, time.Minute))
+	require.NoError(t, err)
+	_, err = node.History("test")
+	require.NoError(t, err)
+	require.NoError(t, node.RemoveHistory("test"))
+	_, err = node.Presence("test")
+	require.NoError(t, err)
+	_, err = node.PresenceStats("test")
+	require.NoError(t, err)
+
+	summary := summaryByOperation(stats)
+	for _, operation := range []string{
+		OperationPublish, OperationHistoryAdd, OperationHistoryGet, OperationHistoryRemove,
+		OperationPresenceGet, OperationPresenceStatsGet,
+	} {
+		require.Contains(t, summary, operation)
+		require.Equal(t, 1, summary[operation].Count, operation)
+		require.Zero(t, summary[operation].ErrorRate)
+	}
+	require.Equal(t, float64(0), testutil.ToFloat64(operationErrorsCount.WithLabelValues(OperationPublish)))
+}
+
+var errEngine = errors.New("engine error")
+
+type failingPresenceManager struct {
+	centrifuge.PresenceManager
+}
+
+func (m *failingPresenceManager) AddPresence(_ string, _ string, _ *centrifuge.ClientInfo, _ time.Duration) error {
+	return errEngine
+}
+
+func TestEngineOperationErrorsRecorded(t *testing.T) {
+	stats := New()
+	pm := NewPresenceManager(&failingPresenceManager{}, stats)
+	before := testutil.ToFloat64(operationErrorsCount.WithLabelValues(OperationPresenceAdd))
+	require.Equal(t, errEngine, pm.AddPresence("test", "client", &centrifuge.ClientInfo{}, time.Minute))
+	require.Equal(t, errEngine, pm.AddPresence("test", "client", &centrifuge.ClientInfo{}, time.Minute))
+	require.Equal(t, before+2, testutil.ToFloat64(operationErrorsCount.WithLabelValues(OperationPresenceAdd)))
+
+	summary := summaryByOperation(stats)
+	require.Equal(t, 2, summary[OperationPresenceAdd].Count)
+	require.Equal(t, float64(1), summary[OperationPresenceAdd].ErrorRate)
+}
+
+type slowBroker struct {
+	centrifuge.Broker
+	delay time.Duration
+}
+
+func (b *slowBroker) History(_ string, _ centrifuge.HistoryFilter) ([]*centrifuge.Publication, centrifuge.StreamPosition, error) {
+	time.Sleep(b.delay)
+	return nil, centrifuge.StreamPosition{}, nil
+}
+
+func (b *slowBroker) RemoveHistory(_ string) error {
+	return nil
+}
+
+type logEntry struct {
+	Level     string `json:"level"`
+	Message   string `json:"message"`
+	Operation string `json:"operation"`
+	Channel   string `json:"channel"`
+}
+
+func captureLogs() (*bytes.Buffer, func()) {
+	var buf bytes.Buffer
+	logger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	return &buf, func() { log.Logger = logger }
+}
+
+func logEntries(t *testing.T, buf *bytes.Buffer) []logEntry {
+	var entries []logEntry
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry logEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestSlowOperationLogged(t *testing.T) {
+	buf, restore := captureLogs()
+	defer restore()
+
+	stats := New()
+	stats.SetSlowThreshold(10 * time.Millisecond)
+	b := NewBroker(&slowBroker{delay: 50 * time.Millisecond}, stats)
+
+	// Fast operation not logged.
+	require.NoError(t, b.RemoveHistory("test"))
+	require.Len(t, logEntries(t, buf), 0)
+
+	_, _, err := b.History("test", centrifuge.HistoryFilter{})
+	require.NoError(t, err)
+	entries := logEntries(t, buf)
+	require.Len(t, entries, 1)
+	require.Equal(t, logEntry{
+		Level:     "warn",
+		Message:   "slow engine operation",
+		Operation: OperationHistoryGet,
+		Channel:   "test",
+	}, entries[0])
+}
+
+func TestSlowOperationLogDisabled(t *testing.T) {
+	buf, restore := captureLogs()
+	defer restore()
+
+	b := NewBroker(&slowBroker{delay: 20 * time.Millisecond}, New())
+	_, _, err := b.History("test", centrifuge.HistoryFilter{})
+	require.NoError(t, err)
+	require.Len(t, logEntries(t, buf), 0)
+}
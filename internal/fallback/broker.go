@@ -0,0 +1,90 @@
+// Package fallback defines Broker which delivers publications locally when
+// underlying broker fails to publish.
+package fallback
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// PublishFailurePolicy describes behavior on engine publish failure.
+type PublishFailurePolicy string
+
+const (
+	// PublishFailureFail means publish returns error to caller.
+	PublishFailureFail PublishFailurePolicy = "fail"
+	// PublishFailureBestEffort means publication delivered to subscribers
+	// connected to current node only and publish considered successful.
+	PublishFailureBestEffort PublishFailurePolicy = "best_effort"
+)
+
+// Config of Broker.
+type Config struct {
+	// PublishFailurePolicy sets what to do when engine fails to publish.
+	// By default publish fails with error.
+	PublishFailurePolicy PublishFailurePolicy
+}
+
+// Validate ...
+func (c Config) Validate() error {
+	switch c.PublishFailurePolicy {
+	case "", PublishFailureFail, PublishFailureBestEffort:
+		return nil
+	default:
+		return fmt.Errorf("unknown engine publish failure policy: %s", c.PublishFailurePolicy)
+	}
+}
+
+// Broker wraps centrifuge.Broker and applies PublishFailurePolicy when
+// publish fails. With best effort policy publication delivered to channel
+// subscribers connected to current node only.
+type Broker struct {
+	centrifuge.Broker
+	node   *centrifuge.Node
+	config Config
+
+	mu      sync.RWMutex
+	handler centrifuge.BrokerEventHandler
+}
+
+var _ centrifuge.Broker = (*Broker)(nil)
+
+// New creates Broker.
+func New(n *centrifuge.Node, broker centrifuge.Broker, c Config) *Broker {
+	return &Broker{
+		Broker: broker,
+		node:   n,
+		config: c,
+	}
+}
+
+// Run ...
+func (b *Broker) Run(h centrifuge.BrokerEventHandler) error {
+	b.mu.Lock()
+	b.handler = h
+	b.mu.Unlock()
+	return b.Broker.Run(h)
+}
+
+// Publish ...
+func (b *Broker) Publish(ch string, data []byte, opts centrifuge.PublishOptions) (centrifuge.StreamPosition, error) {
+	sp, err := b.Broker.Publish(ch, data, opts)
+	if err == nil || b.config.PublishFailurePolicy != PublishFailureBestEffort {
+		return sp, err
+	}
+	b.mu.RLock()
+	h := b.handler
+	b.mu.RUnlock()
+	if h == nil {
+		return sp, err
+	}
+	// Publication delivered without offset as it was not added to history
+	// stream, so clients can't recover it later.
+	if handleErr := h.HandlePublication(ch, &centrifuge.Publication{Data: data, Info: opts.ClientInfo}); handleErr != nil {
+		return sp, err
+	}
+	b.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "engine publish failed, publication delivered to local subscribers only", map[string]interface{}{"error": err.Error(), "channel": ch}))
+	return centrifuge.StreamPosition{}, nil
+}
@@ -0,0 +1,98 @@
+package fallback
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+var errPublish = errors.New("publish timeout")
+
+type failingBroker struct {
+	centrifuge.Broker
+}
+
+func (b *failingBroker) Publish(_ string, _ []byte, _ centrifuge.PublishOptions) (centrifuge.StreamPosition, error) {
+	return centrifuge.StreamPosition{}, errPublish
+}
+
+type recordingHandler struct {
+	centrifuge.BrokerEventHandler
+	mu           sync.Mutex
+	publications map[string][]*centrifuge.Publication
+}
+
+func (h *recordingHandler) HandlePublication(ch string, pub *centrifuge.Publication) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.publications[ch] = append(h.publications[ch], pub)
+	return nil
+}
+
+func testBroker(t *testing.T, policy PublishFailurePolicy) (*Broker, *recordingHandler) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	b := New(node, &failingBroker{Broker: engine}, Config{PublishFailurePolicy: policy})
+	handler := &recordingHandler{publications: make(map[string][]*centrifuge.Publication)}
+	require.NoError(t, b.Run(handler))
+	return b, handler
+}
+
+func TestBrokerPublishFailurePolicyFail(t *testing.T) {
+	for _, policy := range []PublishFailurePolicy{"", PublishFailureFail} {
+		b, handler := testBroker(t, policy)
+		_, err := b.Publish("chat", []byte(`{}`), centrifuge.PublishOptions{})
+		require.Equal(t, errPublish, err)
+		require.Len(t, handler.publications["chat"], 0)
+	}
+}
+
+func TestBrokerPublishFailurePolicyBestEffort(t *testing.T) {
+	b, handler := testBroker(t, PublishFailureBestEffort)
+	info := &centrifuge.ClientInfo{ClientID: "client", UserID: "42"}
+	_, err := b.Publish("chat", []byte(`{"input":1}`), centrifuge.PublishOptions{ClientInfo: info})
+	require.NoError(t, err)
+	require.Len(t, handler.publications["chat"], 1)
+	pub := handler.publications["chat"][0]
+	require.Equal(t, []byte(`{"input":1}`), pub.Data)
+	require.Equal(t, info, pub.Info)
+	require.Zero(t, pub.Offset)
+}
+
+func TestNodePublishFailurePolicy(t *testing.T) {
+	for _, tc := range []struct {
+		policy  PublishFailurePolicy
+		wantErr bool
+	}{
+		{PublishFailureFail, true},
+		{PublishFailureBestEffort, false},
+	} {
+		node, err := centrifuge.New(centrifuge.DefaultConfig)
+		require.NoError(t, err)
+		engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+		require.NoError(t, err)
+		node.SetEngine(engine)
+		node.SetBroker(New(node, &failingBroker{Broker: engine}, Config{PublishFailurePolicy: tc.policy}))
+		require.NoError(t, node.Run())
+
+		_, err = node.Publish("chat", []byte(`{}`))
+		if tc.wantErr {
+			require.Equal(t, errPublish, err)
+		} else {
+			require.NoError(t, err)
+		}
+		_ = node.Shutdown(context.Background())
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, Config{}.Validate())
+	require.NoError(t, Config{PublishFailurePolicy: PublishFailureBestEffort}.Validate())
+	require.Error(t, Config{PublishFailurePolicy: "retry"}.Validate())
+}
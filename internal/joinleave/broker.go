@@ -0,0 +1,137 @@
+// Package joinleave defines Broker which batches join/leave messages.
+package joinleave
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// BatchType is a value of type field in batched join/leave publication.
+const BatchType = "join_leave"
+
+// ClientInfo describes client in batched join/leave publication.
+type ClientInfo struct {
+	User     string          `json:"user"`
+	Client   string          `json:"client"`
+	ConnInfo json.RawMessage `json:"conn_info,omitempty"`
+	ChanInfo json.RawMessage `json:"chan_info,omitempty"`
+}
+
+// Batch is a publication data sent to channel subscribers instead of
+// separate join and leave messages.
+type Batch struct {
+	Type  string        `json:"type"`
+	Join  []*ClientInfo `json:"join,omitempty"`
+	Leave []*ClientInfo `json:"leave,omitempty"`
+}
+
+// Broker wraps centrifuge.Broker and collects join/leave messages for
+// channels with JoinLeaveBatchInterval configured. Collected messages
+// published as one Batch publication once interval passed.
+type Broker struct {
+	centrifuge.Broker
+	node          *centrifuge.Node
+	ruleContainer *rule.Container
+
+	mu      sync.Mutex
+	batches map[string]*Batch
+}
+
+var _ centrifuge.Broker = (*Broker)(nil)
+
+// New creates Broker.
+func New(n *centrifuge.Node, broker centrifuge.Broker, ruleContainer *rule.Container) *Broker {
+	return &Broker{
+		Broker:        broker,
+		node:          n,
+		ruleContainer: ruleContainer,
+		batches:       make(map[string]*Batch),
+	}
+}
+
+func rawInfo(info []byte) json.RawMessage {
+	if len(info) == 0 || !json.Valid(info) {
+		return nil
+	}
+	return info
+}
+
+func toClientInfo(info *centrifuge.ClientInfo) *ClientInfo {
+	return &ClientInfo{
+		User:     info.UserID,
+		Client:   info.ClientID,
+		ConnInfo: rawInfo(info.ConnInfo),
+		ChanInfo: rawInfo(info.ChanInfo),
+	}
+}
+
+func (b *Broker) batchInterval(ch string) time.Duration {
+	chOpts, found, err := b.ruleContainer.ChannelOptions(ch)
+	if err != nil || !found {
+		return 0
+	}
+	return time.Duration(chOpts.JoinLeaveBatchInterval) * time.Second
+}
+
+// add returns false if message must be published immediately.
+func (b *Broker) add(ch string, info *centrifuge.ClientInfo, join bool) bool {
+	interval := b.batchInterval(ch)
+	if interval <= 0 {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	batch, ok := b.batches[ch]
+	if !ok {
+		batch = &Batch{Type: BatchType}
+		b.batches[ch] = batch
+		time.AfterFunc(interval, func() {
+			b.flush(ch)
+		})
+	}
+	if join {
+		batch.Join = append(batch.Join, toClientInfo(info))
+	} else {
+		batch.Leave = append(batch.Leave, toClientInfo(info))
+	}
+	return true
+}
+
+func (b *Broker) flush(ch string) {
+	b.mu.Lock()
+	batch, ok := b.batches[ch]
+	delete(b.batches, ch)
+	b.mu.Unlock()
+	if !ok {
+		return
+	}
+	data, err := json.Marshal(batch)
+	if err != nil {
+		b.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error encoding join/leave batch", map[string]interface{}{"channel": ch, "error": err.Error()}))
+		return
+	}
+	if _, err := b.Broker.Publish(ch, data, centrifuge.PublishOptions{}); err != nil {
+		b.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "error publishing join/leave batch", map[string]interface{}{"channel": ch, "error": err.Error()}))
+	}
+}
+
+// PublishJoin ...
+func (b *Broker) PublishJoin(ch string, info *centrifuge.ClientInfo) error {
+	if b.add(ch, info, true) {
+		return nil
+	}
+	return b.Broker.PublishJoin(ch, info)
+}
+
+// PublishLeave ...
+func (b *Broker) PublishLeave(ch string, info *centrifuge.ClientInfo) error {
+	if b.add(ch, info, false) {
+		return nil
+	}
+	return b.Broker.PublishLeave(ch, info)
+}
@@ -0,0 +1,110 @@
+package joinleave
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingBroker struct {
+	centrifuge.Broker
+	mu           sync.Mutex
+	publications map[string][][]byte
+	numJoins     int
+	numLeaves    int
+}
+
+func newRecordingBroker() *recordingBroker {
+	return &recordingBroker{publications: make(map[string][][]byte)}
+}
+
+func (b *recordingBroker) Publish(ch string, data []byte, _ centrifuge.PublishOptions) (centrifuge.StreamPosition, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.publications[ch] = append(b.publications[ch], data)
+	return centrifuge.StreamPosition{}, nil
+}
+
+func (b *recordingBroker) PublishJoin(_ string, _ *centrifuge.ClientInfo) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.numJoins++
+	return nil
+}
+
+func (b *recordingBroker) PublishLeave(_ string, _ *centrifuge.ClientInfo) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.numLeaves++
+	return nil
+}
+
+func (b *recordingBroker) channelPublications(ch string) [][]byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.publications[ch]
+}
+
+func testBroker(t *testing.T) (*Broker, *recordingBroker) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{
+		Name: "batched",
+		ChannelOptions: rule.ChannelOptions{
+			JoinLeave:              true,
+			JoinLeaveBatchInterval: 1,
+		},
+	}}
+	recorder := newRecordingBroker()
+	return New(node, recorder, rule.NewContainer(ruleConfig)), recorder
+}
+
+func TestBrokerBatchesJoinLeave(t *testing.T) {
+	b, recorder := testBroker(t)
+
+	for i := 0; i < 12; i++ {
+		info := &centrifuge.ClientInfo{UserID: strconv.Itoa(i), ClientID: "client" + strconv.Itoa(i), ConnInfo: []byte(`{"i":1}`)}
+		require.NoError(t, b.PublishJoin("batched:chat", info))
+	}
+	for i := 0; i < 3; i++ {
+		info := &centrifuge.ClientInfo{UserID: strconv.Itoa(i), ClientID: "client" + strconv.Itoa(i)}
+		require.NoError(t, b.PublishLeave("batched:chat", info))
+	}
+	require.Len(t, recorder.channelPublications("batched:chat"), 0)
+
+	deadline := time.Now().Add(3 * time.Second)
+	for len(recorder.channelPublications("batched:chat")) == 0 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+	publications := recorder.channelPublications("batched:chat")
+	require.Len(t, publications, 1)
+
+	var batch Batch
+	require.NoError(t, json.Unmarshal(publications[0], &batch))
+	require.Equal(t, BatchType, batch.Type)
+	require.Len(t, batch.Join, 12)
+	require.Len(t, batch.Leave, 3)
+	require.Equal(t, "client0", batch.Join[0].Client)
+	require.JSONEq(t, `{"i":1}`, string(batch.Join[0].ConnInfo))
+	require.Equal(t, 0, recorder.numJoins)
+	require.Equal(t, 0, recorder.numLeaves)
+}
+
+func TestBrokerPassThroughJoinLeave(t *testing.T) {
+	b, recorder := testBroker(t)
+	info := &centrifuge.ClientInfo{UserID: "1", ClientID: "client"}
+	require.NoError(t, b.PublishJoin("chat", info))
+	require.NoError(t, b.PublishLeave("chat", info))
+	require.NoError(t, b.PublishJoin("unknown:chat", info))
+	require.Equal(t, 2, recorder.numJoins)
+	require.Equal(t, 1, recorder.numLeaves)
+	require.Len(t, recorder.channelPublications("chat"), 0)
+}
@@ -42,4 +42,8 @@ type SubscribeToken struct {
 	// expiration but not turn on Subscription expiration checks on server side.
 	// This allows to implement one-time subscription tokens.
 	ExpireTokenOnly bool
+	// SubscriptionExpireAt allows to set time in future when client must be
+	// unsubscribed from channel. Unlike ExpireAt this only affects a single
+	// subscription – connection stays alive.
+	SubscriptionExpireAt int64
 }
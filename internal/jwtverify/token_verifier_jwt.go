@@ -31,17 +31,103 @@ type VerifierConfig struct {
 	// JWKSPublicEndpoint is a public url used to validate connection and subscription
 	// tokens generated using rotating RSA public keys. Zero value means that JSON Web Key Sets extension won't be used.
 	JWKSPublicEndpoint string
+
+	// ConnectHMACSecretKey when set is used instead of HMACSecretKey to
+	// validate connection tokens generated using HMAC.
+	ConnectHMACSecretKey string
+
+	// SubscribeHMACSecretKey when set is used instead of HMACSecretKey to
+	// validate subscription tokens generated using HMAC.
+	SubscribeHMACSecretKey string
+
+	// SecondaryHMACSecretKeys are additionally accepted to validate HMAC
+	// tokens which do not match primary key. This allows to rotate secret
+	// key without invalidating tokens issued with previous one. Only used
+	// for tokens validated with HMACSecretKey, so secondary keys never make
+	// token for one purpose valid for another one.
+	SecondaryHMACSecretKeys []string
+
+	// ConnectSecondaryHMACSecretKeys are secondary keys for connection tokens
+	// when ConnectHMACSecretKey set.
+	ConnectSecondaryHMACSecretKeys []string
+
+	// SubscribeSecondaryHMACSecretKeys are secondary keys for subscription
+	// tokens when SubscribeHMACSecretKey set.
+	SubscribeSecondaryHMACSecretKeys []string
+}
+
+// Validate VerifierConfig.
+func (c VerifierConfig) Validate() error {
+	if err := validateSecondaryKeys("", c.SecondaryHMACSecretKeys); err != nil {
+		return err
+	}
+	if err := validateSecondaryKeys("connect ", c.ConnectSecondaryHMACSecretKeys); err != nil {
+		return err
+	}
+	if err := validateSecondaryKeys("subscribe ", c.SubscribeSecondaryHMACSecretKeys); err != nil {
+		return err
+	}
+	if len(c.ConnectSecondaryHMACSecretKeys) > 0 && c.ConnectHMACSecretKey == "" {
+		return errors.New("connect secondary HMAC secret keys require connect HMAC secret key")
+	}
+	if len(c.SubscribeSecondaryHMACSecretKeys) > 0 && c.SubscribeHMACSecretKey == "" {
+		return errors.New("subscribe secondary HMAC secret keys require subscribe HMAC secret key")
+	}
+	return nil
+}
+
+func validateSecondaryKeys(purpose string, keys []string) error {
+	for i, key := range keys {
+		if key == "" {
+			return fmt.Errorf("empty %ssecondary HMAC secret key at index %d", purpose, i)
+		}
+	}
+	return nil
+}
+
+func (c VerifierConfig) connectHMACSecretKey() string {
+	if c.ConnectHMACSecretKey != "" {
+		return c.ConnectHMACSecretKey
+	}
+	return c.HMACSecretKey
+}
+
+func (c VerifierConfig) connectSecondaryHMACSecretKeys() []string {
+	if c.ConnectHMACSecretKey != "" {
+		return c.ConnectSecondaryHMACSecretKeys
+	}
+	return c.SecondaryHMACSecretKeys
+}
+
+func (c VerifierConfig) subscribeHMACSecretKey() string {
+	if c.SubscribeHMACSecretKey != "" {
+		return c.SubscribeHMACSecretKey
+	}
+	return c.HMACSecretKey
+}
+
+func (c VerifierConfig) subscribeSecondaryHMACSecretKeys() []string {
+	if c.SubscribeHMACSecretKey != "" {
+		return c.SubscribeSecondaryHMACSecretKeys
+	}
+	return c.SecondaryHMACSecretKeys
 }
 
 func NewTokenVerifierJWT(config VerifierConfig) *VerifierJWT {
 	verifier := &VerifierJWT{}
 
-	algorithms, err := newAlgorithms(config.HMACSecretKey, config.RSAPublicKey, config.ECDSAPublicKey)
+	algorithms, err := newAlgorithms(config.connectHMACSecretKey(), config.connectSecondaryHMACSecretKeys(), config.RSAPublicKey, config.ECDSAPublicKey)
 	if err != nil {
 		panic(err)
 	}
 	verifier.algorithms = algorithms
 
+	subscribeAlgorithms, err := newAlgorithms(config.subscribeHMACSecretKey(), config.subscribeSecondaryHMACSecretKeys(), config.RSAPublicKey, config.ECDSAPublicKey)
+	if err != nil {
+		panic(err)
+	}
+	verifier.subscribeAlgorithms = subscribeAlgorithms
+
 	if config.JWKSPublicEndpoint != "" {
 		mng, err := jwks.NewManager(config.JWKSPublicEndpoint)
 		if err == nil {
@@ -53,9 +139,10 @@ func NewTokenVerifierJWT(config VerifierConfig) *VerifierJWT {
 }
 
 type VerifierJWT struct {
-	mu          sync.RWMutex
-	jwksManager *jwksManager
-	algorithms  *algorithms
+	mu                  sync.RWMutex
+	jwksManager         *jwksManager
+	algorithms          *algorithms
+	subscribeAlgorithms *algorithms
 }
 
 var (
@@ -78,6 +165,8 @@ type SubscribeTokenClaims struct {
 	Info            json.RawMessage `json:"info,omitempty"`
 	Base64Info      string          `json:"b64info,omitempty"`
 	ExpireTokenOnly bool            `json:"eto,omitempty"`
+	// SubscriptionExpireAt is a Unix time when subscription must be removed.
+	SubscriptionExpireAt int64 `json:"sexp,omitempty"`
 	jwt.StandardClaims
 }
 
@@ -123,11 +212,21 @@ type algorithms struct {
 	ES256 jwt.Verifier
 	ES384 jwt.Verifier
 	ES512 jwt.Verifier
+	// secondaryHMAC used to verify HMAC tokens not matching primary key.
+	secondaryHMAC []*algorithms
 }
 
-func newAlgorithms(tokenHMACSecretKey string, rsaPubKey *rsa.PublicKey, ecdsaPubKey *ecdsa.PublicKey) (*algorithms, error) {
+func newAlgorithms(tokenHMACSecretKey string, secondaryHMACSecretKeys []string, rsaPubKey *rsa.PublicKey, ecdsaPubKey *ecdsa.PublicKey) (*algorithms, error) {
 	alg := &algorithms{}
 
+	for _, key := range secondaryHMACSecretKeys {
+		secondary, err := newAlgorithms(key, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		alg.secondaryHMAC = append(alg.secondaryHMAC, secondary)
+	}
+
 	// HMAC SHA.
 	if tokenHMACSecretKey != "" {
 		verifierHS256, err := jwt.NewVerifierHS(jwt.HS256, []byte(tokenHMACSecretKey))
@@ -215,7 +314,18 @@ func (s *algorithms) verify(token *jwt.Token) error {
 	if verifier == nil {
 		return fmt.Errorf("%w: %s", errDisabledAlgorithm, string(token.Header().Algorithm))
 	}
-	return verifier.Verify(token.Payload(), token.Signature())
+	err := verifier.Verify(token.Payload(), token.Signature())
+	if err != nil {
+		switch token.Header().Algorithm {
+		case jwt.HS256, jwt.HS384, jwt.HS512:
+			for _, secondary := range s.secondaryHMAC {
+				if secondary.verify(token) == nil {
+					return nil
+				}
+			}
+		}
+	}
+	return err
 }
 
 func (verifier *VerifierJWT) verifySignature(token *jwt.Token) error {
@@ -225,6 +335,13 @@ func (verifier *VerifierJWT) verifySignature(token *jwt.Token) error {
 	return verifier.algorithms.verify(token)
 }
 
+func (verifier *VerifierJWT) verifySubscribeSignature(token *jwt.Token) error {
+	verifier.mu.RLock()
+	defer verifier.mu.RUnlock()
+
+	return verifier.subscribeAlgorithms.verify(token)
+}
+
 func (verifier *VerifierJWT) verifySignatureByJWK(token *jwt.Token) error {
 	verifier.mu.RLock()
 	defer verifier.mu.RUnlock()
@@ -288,7 +405,7 @@ func (verifier *VerifierJWT) VerifySubscribeToken(t string) (SubscribeToken, err
 	if verifier.jwksManager != nil {
 		err = verifier.verifySignatureByJWK(token)
 	} else {
-		err = verifier.verifySignature(token)
+		err = verifier.verifySubscribeSignature(token)
 	}
 	if err != nil {
 		return SubscribeToken{}, err
@@ -304,12 +421,16 @@ func (verifier *VerifierJWT) VerifySubscribeToken(t string) (SubscribeToken, err
 	if !claims.IsValidExpiresAt(now) || !claims.IsValidNotBefore(now) {
 		return SubscribeToken{}, ErrTokenExpired
 	}
+	if claims.SubscriptionExpireAt > 0 && claims.SubscriptionExpireAt <= now.Unix() {
+		return SubscribeToken{}, ErrTokenExpired
+	}
 
 	st := SubscribeToken{
-		Client:          claims.Client,
-		Info:            claims.Info,
-		Channel:         claims.Channel,
-		ExpireTokenOnly: claims.ExpireTokenOnly,
+		Client:               claims.Client,
+		Info:                 claims.Info,
+		Channel:              claims.Channel,
+		ExpireTokenOnly:      claims.ExpireTokenOnly,
+		SubscriptionExpireAt: claims.SubscriptionExpireAt,
 	}
 	if claims.ExpiresAt != nil {
 		st.ExpireAt = claims.ExpiresAt.Unix()
@@ -327,10 +448,15 @@ func (verifier *VerifierJWT) VerifySubscribeToken(t string) (SubscribeToken, err
 func (verifier *VerifierJWT) Reload(config VerifierConfig) error {
 	verifier.mu.Lock()
 	defer verifier.mu.Unlock()
-	alg, err := newAlgorithms(config.HMACSecretKey, config.RSAPublicKey, config.ECDSAPublicKey)
+	alg, err := newAlgorithms(config.connectHMACSecretKey(), config.connectSecondaryHMACSecretKeys(), config.RSAPublicKey, config.ECDSAPublicKey)
+	if err != nil {
+		return err
+	}
+	subscribeAlg, err := newAlgorithms(config.subscribeHMACSecretKey(), config.subscribeSecondaryHMACSecretKeys(), config.RSAPublicKey, config.ECDSAPublicKey)
 	if err != nil {
 		return err
 	}
 	verifier.algorithms = alg
+	verifier.subscribeAlgorithms = subscribeAlg
 	return nil
 }
@@ -185,13 +185,13 @@ func getJWKServer(pubKey *rsa.PublicKey, kty, use, kid string) *httptest.Server
 func Test_tokenVerifierJWT_Signer(t *testing.T) {
 	_, rsaPubKey := generateTestRSAKeys(t)
 	_, ecdsaPubKey := generateTestECDSAKeys(t)
-	signer, err := newAlgorithms("secret", rsaPubKey, ecdsaPubKey)
+	signer, err := newAlgorithms("secret", nil, rsaPubKey, ecdsaPubKey)
 	require.NoError(t, err)
 	require.NotNil(t, signer)
 }
 
 func Test_tokenVerifierJWT_Valid(t *testing.T) {
-	verifier := NewTokenVerifierJWT(VerifierConfig{"secret", nil, nil, ""})
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret"})
 	ct, err := verifier.VerifyConnectToken(jwtValid)
 	require.NoError(t, err)
 	require.Equal(t, "2694", ct.UserID)
@@ -200,45 +200,137 @@ func Test_tokenVerifierJWT_Valid(t *testing.T) {
 }
 
 func Test_tokenVerifierJWT_Expired(t *testing.T) {
-	verifier := NewTokenVerifierJWT(VerifierConfig{"secret", nil, nil, ""})
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret"})
 	_, err := verifier.VerifyConnectToken(jwtExpired)
 	require.Error(t, err)
 	require.Equal(t, ErrTokenExpired, err)
 }
 
 func Test_tokenVerifierJWT_DisabledAlgorithm(t *testing.T) {
-	verifier := NewTokenVerifierJWT(VerifierConfig{"", nil, nil, ""})
+	verifier := NewTokenVerifierJWT(VerifierConfig{})
 	_, err := verifier.VerifyConnectToken(jwtExpired)
 	require.Error(t, err)
 	require.True(t, errors.Is(err, errDisabledAlgorithm), err.Error())
 }
 
 func Test_tokenVerifierJWT_InvalidSignature(t *testing.T) {
-	verifier := NewTokenVerifierJWT(VerifierConfig{"secret", nil, nil, ""})
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret"})
 	_, err := verifier.VerifyConnectToken(jwtInvalidSignature)
 	require.Error(t, err)
 }
 
 func Test_tokenVerifierJWT_WithNotBefore(t *testing.T) {
-	verifier := NewTokenVerifierJWT(VerifierConfig{"secret", nil, nil, ""})
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret"})
 	_, err := verifier.VerifyConnectToken(jwtNotBefore)
 	require.Error(t, err)
 }
 
 func Test_tokenVerifierJWT_StringAudience(t *testing.T) {
-	verifier := NewTokenVerifierJWT(VerifierConfig{"secret", nil, nil, ""})
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret"})
 	ct, err := verifier.VerifyConnectToken(jwtStringAud)
 	require.NoError(t, err)
 	require.Equal(t, "2694", ct.UserID)
 }
 
 func Test_tokenVerifierJWT_ArrayAudience(t *testing.T) {
-	verifier := NewTokenVerifierJWT(VerifierConfig{"secret", nil, nil, ""})
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret"})
 	ct, err := verifier.VerifyConnectToken(jwtArrayAud)
 	require.NoError(t, err)
 	require.Equal(t, "2694", ct.UserID)
 }
 
+func Test_tokenVerifierJWT_SeparateSecrets(t *testing.T) {
+	// Test tokens signed with `secret`.
+	connectToken := getRSAConnToken("2694", 0, nil)
+	subscribeToken := getRSASubscribeToken("$private", "client", 0, nil)
+
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "main", ConnectHMACSecretKey: "secret"})
+	_, err := verifier.VerifyConnectToken(connectToken)
+	require.NoError(t, err)
+	_, err = verifier.VerifySubscribeToken(subscribeToken)
+	require.Error(t, err)
+
+	verifier = NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "main", SubscribeHMACSecretKey: "secret"})
+	_, err = verifier.VerifyConnectToken(connectToken)
+	require.Error(t, err)
+	_, err = verifier.VerifySubscribeToken(subscribeToken)
+	require.NoError(t, err)
+
+	// Both fall back to main secret.
+	verifier = NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret"})
+	_, err = verifier.VerifyConnectToken(connectToken)
+	require.NoError(t, err)
+	_, err = verifier.VerifySubscribeToken(subscribeToken)
+	require.NoError(t, err)
+
+	// Separate secrets applied on reload.
+	require.NoError(t, verifier.Reload(VerifierConfig{HMACSecretKey: "secret", ConnectHMACSecretKey: "other"}))
+	_, err = verifier.VerifyConnectToken(connectToken)
+	require.Error(t, err)
+	_, err = verifier.VerifySubscribeToken(subscribeToken)
+	require.NoError(t, err)
+}
+
+func Test_tokenVerifierJWT_SecondarySecrets(t *testing.T) {
+	// Test tokens signed with `secret`.
+	connectToken := getRSAConnToken("2694", 0, nil)
+	subscribeToken := getRSASubscribeToken("$private", "client", 0, nil)
+
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "new", SecondaryHMACSecretKeys: []string{"old", "secret"}})
+	ct, err := verifier.VerifyConnectToken(connectToken)
+	require.NoError(t, err)
+	require.Equal(t, "2694", ct.UserID)
+	_, err = verifier.VerifySubscribeToken(subscribeToken)
+	require.NoError(t, err)
+
+	verifier = NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "new", SecondaryHMACSecretKeys: []string{"old"}})
+	_, err = verifier.VerifyConnectToken(connectToken)
+	require.Error(t, err)
+	_, err = verifier.VerifySubscribeToken(subscribeToken)
+	require.Error(t, err)
+
+	// Secondary secrets dropped on reload.
+	verifier = NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "new", SecondaryHMACSecretKeys: []string{"secret"}})
+	require.NoError(t, verifier.Reload(VerifierConfig{HMACSecretKey: "new"}))
+	_, err = verifier.VerifyConnectToken(connectToken)
+	require.Error(t, err)
+}
+
+func Test_tokenVerifierJWT_SecondarySecretsCrossPurpose(t *testing.T) {
+	// Test tokens signed with `secret`.
+	connectToken := getRSAConnToken("2694", 0, nil)
+	subscribeToken := getRSASubscribeToken("$private", "client", 0, nil)
+
+	// Shared secondary keys do not apply to dedicated subscribe key.
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "new", SubscribeHMACSecretKey: "subscribe", SecondaryHMACSecretKeys: []string{"secret"}})
+	_, err := verifier.VerifyConnectToken(connectToken)
+	require.NoError(t, err)
+	_, err = verifier.VerifySubscribeToken(subscribeToken)
+	require.Error(t, err)
+
+	// Dedicated secondary keys apply to their own purpose only.
+	verifier = NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "new", SubscribeHMACSecretKey: "subscribe", SubscribeSecondaryHMACSecretKeys: []string{"secret"}})
+	_, err = verifier.VerifyConnectToken(connectToken)
+	require.Error(t, err)
+	_, err = verifier.VerifySubscribeToken(subscribeToken)
+	require.NoError(t, err)
+
+	require.NoError(t, verifier.Reload(VerifierConfig{HMACSecretKey: "new", ConnectHMACSecretKey: "connect", ConnectSecondaryHMACSecretKeys: []string{"secret"}}))
+	_, err = verifier.VerifyConnectToken(connectToken)
+	require.NoError(t, err)
+	_, err = verifier.VerifySubscribeToken(subscribeToken)
+	require.Error(t, err)
+}
+
+func TestVerifierConfigValidate(t *testing.T) {
+	require.NoError(t, VerifierConfig{HMACSecretKey: "new", SecondaryHMACSecretKeys: []string{"old"}}.Validate())
+	require.Error(t, VerifierConfig{HMACSecretKey: "new", SecondaryHMACSecretKeys: []string{"old", ""}}.Validate())
+	require.NoError(t, VerifierConfig{ConnectHMACSecretKey: "new", ConnectSecondaryHMACSecretKeys: []string{"old"}}.Validate())
+	require.Error(t, VerifierConfig{ConnectHMACSecretKey: "new", ConnectSecondaryHMACSecretKeys: []string{""}}.Validate())
+	require.Error(t, VerifierConfig{HMACSecretKey: "new", ConnectSecondaryHMACSecretKeys: []string{"old"}}.Validate())
+	require.Error(t, VerifierConfig{HMACSecretKey: "new", SubscribeSecondaryHMACSecretKeys: []string{"old"}}.Validate())
+}
+
 func Test_tokenVerifierJWT_VerifyConnectToken(t *testing.T) {
 	type args struct {
 		token string
@@ -247,7 +339,7 @@ func Test_tokenVerifierJWT_VerifyConnectToken(t *testing.T) {
 	rsaPrivateKey, rsaPubKey := generateTestRSAKeys(t)
 	ecdsaPrivateKey, ecdsaPubKey := generateTestECDSAKeys(t)
 
-	verifierJWT := NewTokenVerifierJWT(VerifierConfig{"secret", rsaPubKey, ecdsaPubKey, ""})
+	verifierJWT := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret", RSAPublicKey: rsaPubKey, ECDSAPublicKey: ecdsaPubKey})
 	_time := time.Now()
 	tests := []struct {
 		name     string
@@ -401,7 +493,7 @@ func Test_tokenVerifierJWT_VerifyConnectTokenWithJWK(t *testing.T) {
 			ts.Start()
 			defer ts.Close()
 
-			verifier := NewTokenVerifierJWT(VerifierConfig{"", nil, nil, ts.URL})
+			verifier := NewTokenVerifierJWT(VerifierConfig{JWKSPublicEndpoint: ts.URL})
 			token := getRSAConnToken(tt.token.user, tt.token.exp, privKey, jwt.WithKeyID(tt.jwk.kid))
 
 			got, err := verifier.VerifyConnectToken(token)
@@ -429,7 +521,7 @@ func Test_tokenVerifierJWT_VerifySubscribeToken(t *testing.T) {
 	rsaPrivateKey, rsaPubKey := generateTestRSAKeys(t)
 	ecdsaPrivateKey, ecdsaPubKey := generateTestECDSAKeys(t)
 
-	verifierJWT := NewTokenVerifierJWT(VerifierConfig{"secret", rsaPubKey, ecdsaPubKey, ""})
+	verifierJWT := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret", RSAPublicKey: rsaPubKey, ECDSAPublicKey: ecdsaPubKey})
 	_time := time.Now()
 	tests := []struct {
 		name     string
@@ -526,7 +618,7 @@ func Test_tokenVerifierJWT_VerifySubscribeToken(t *testing.T) {
 }
 
 func BenchmarkConnectTokenVerify_Valid(b *testing.B) {
-	verifierJWT := NewTokenVerifierJWT(VerifierConfig{"secret", nil, nil, ""})
+	verifierJWT := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret"})
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		_, err := verifierJWT.VerifyConnectToken(jwtValid)
@@ -539,7 +631,7 @@ func BenchmarkConnectTokenVerify_Valid(b *testing.B) {
 }
 
 func BenchmarkConnectTokenVerify_Expired(b *testing.B) {
-	verifier := NewTokenVerifierJWT(VerifierConfig{"secret", nil, nil, ""})
+	verifier := NewTokenVerifierJWT(VerifierConfig{HMACSecretKey: "secret"})
 	for i := 0; i < b.N; i++ {
 		_, err := verifier.VerifyConnectToken(jwtExpired)
 		if err != ErrTokenExpired {
@@ -0,0 +1,55 @@
+// Package maintenance keeps maintenance mode state of node.
+package maintenance
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// State of maintenance mode.
+type State struct {
+	// Enabled turns on maintenance mode. Clients connected while maintenance
+	// mode enabled receive message with maintenance notice.
+	Enabled bool
+	// Message sent to connecting clients.
+	Message string
+	// BlockSubscribe rejects client subscriptions while maintenance mode enabled.
+	BlockSubscribe bool
+}
+
+// Mode holds current maintenance State, safe for concurrent use.
+type Mode struct {
+	mu    sync.RWMutex
+	state State
+}
+
+// New creates Mode with initial State.
+func New(s State) *Mode {
+	return &Mode{state: s}
+}
+
+// State returns current maintenance state.
+func (m *Mode) State() State {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state
+}
+
+// Set replaces current maintenance state.
+func (m *Mode) Set(s State) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.state = s
+}
+
+type notice struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// Notice returns data of message sent to clients connecting in maintenance
+// mode.
+func Notice(message string) []byte {
+	data, _ := json.Marshal(notice{Type: "maintenance", Message: message})
+	return data
+}
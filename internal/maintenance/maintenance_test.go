@@ -0,0 +1,18 @@
+package maintenance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMode(t *testing.T) {
+	m := New(State{})
+	require.False(t, m.State().Enabled)
+	m.Set(State{Enabled: true, Message: "back soon", BlockSubscribe: true})
+	require.Equal(t, State{Enabled: true, Message: "back soon", BlockSubscribe: true}, m.State())
+}
+
+func TestNotice(t *testing.T) {
+	require.JSONEq(t, `{"type": "maintenance", "message": "back \"soon\""}`, string(Notice(`back "soon"`)))
+}
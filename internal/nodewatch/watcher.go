@@ -0,0 +1,116 @@
+// Package nodewatch watches nodes known to current node and warns when node
+// set grows too large or contains nodes which stopped sending updates but
+// were not evicted.
+package nodewatch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/rs/zerolog/log"
+)
+
+// Config of Watcher.
+type Config struct {
+	// SoftLimit is a number of tracked nodes above which Watcher logs warning.
+	// Zero value disables check.
+	SoftLimit int
+}
+
+// Node info updated every few seconds and nodes which were not seen for
+// several update intervals evicted by centrifuge. When node info stays the
+// same much longer than that something went wrong with eviction.
+const (
+	checkInterval = 10 * time.Second
+	staleDelay    = 30 * time.Second
+)
+
+type infoSource interface {
+	Info() (centrifuge.Info, error)
+}
+
+type nodeState struct {
+	uptime    uint32
+	updatedAt time.Time
+	reported  bool
+}
+
+// Watcher periodically checks node set.
+type Watcher struct {
+	node    *centrifuge.Node
+	source  infoSource
+	config  Config
+	nowFunc func() time.Time
+
+	mu        sync.Mutex
+	nodes     map[string]*nodeState
+	overLimit bool
+}
+
+// New creates Watcher.
+func New(n *centrifuge.Node, c Config) *Watcher {
+	return &Watcher{
+		node:    n,
+		source:  n,
+		config:  c,
+		nowFunc: time.Now,
+		nodes:   make(map[string]*nodeState),
+	}
+}
+
+// Run checks nodes until node shutdown.
+func (w *Watcher) Run() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.node.NotifyShutdown():
+			return
+		case <-ticker.C:
+			w.check()
+		}
+	}
+}
+
+func (w *Watcher) check() {
+	info, err := w.source.Info()
+	if err != nil {
+		log.Error().Err(err).Msg("error getting node info")
+		return
+	}
+	now := w.nowFunc()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	numNodes := len(info.Nodes)
+	if w.config.SoftLimit > 0 {
+		if numNodes > w.config.SoftLimit && !w.overLimit {
+			w.overLimit = true
+			log.Warn().Int("num_nodes", numNodes).Int("limit", w.config.SoftLimit).Msg("number of nodes exceeds soft limit")
+		} else if numNodes <= w.config.SoftLimit && w.overLimit {
+			w.overLimit = false
+			log.Info().Int("num_nodes", numNodes).Int("limit", w.config.SoftLimit).Msg("number of nodes is back under soft limit")
+		}
+	}
+
+	seen := make(map[string]struct{}, numNodes)
+	for _, nd := range info.Nodes {
+		seen[nd.UID] = struct{}{}
+		state, ok := w.nodes[nd.UID]
+		if !ok || state.uptime != nd.Uptime {
+			w.nodes[nd.UID] = &nodeState{uptime: nd.Uptime, updatedAt: now}
+			continue
+		}
+		if !state.reported && now.Sub(state.updatedAt) > staleDelay {
+			state.reported = true
+			log.Warn().Str("node", nd.UID).Str("name", nd.Name).Time("since", state.updatedAt).Msg("node info not updated but node was not evicted")
+		}
+	}
+	for uid := range w.nodes {
+		if _, ok := seen[uid]; !ok {
+			delete(w.nodes, uid)
+		}
+	}
+}
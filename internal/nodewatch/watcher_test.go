@@ -0,0 +1,124 @@
+package nodewatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSource struct {
+	nodes []centrifuge.NodeInfo
+}
+
+func (s *fakeSource) Info() (centrifuge.Info, error) {
+	return centrifuge.Info{Nodes: s.nodes}, nil
+}
+
+type logEntry struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+	Node    string `json:"node"`
+}
+
+// captureLogs redirects global logger into buffer until returned function
+// called.
+func captureLogs() (*bytes.Buffer, func()) {
+	var buf bytes.Buffer
+	logger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	return &buf, func() { log.Logger = logger }
+}
+
+func logEntries(t *testing.T, buf *bytes.Buffer, level string) []logEntry {
+	var entries []logEntry
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry logEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		if entry.Level == level {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func testWatcher(t *testing.T, c Config) (*Watcher, *fakeSource, *time.Time) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	w := New(node, c)
+	source := &fakeSource{}
+	w.source = source
+	now := time.Unix(1600000000, 0)
+	w.nowFunc = func() time.Time { return now }
+	return w, source, &now
+}
+
+func TestWatcherSoftLimit(t *testing.T) {
+	buf, restore := captureLogs()
+	defer restore()
+	w, source, _ := testWatcher(t, Config{SoftLimit: 2})
+
+	source.nodes = []centrifuge.NodeInfo{{UID: "1"}, {UID: "2"}}
+	w.check()
+	require.Len(t, logEntries(t, buf, "warn"), 0)
+
+	source.nodes = append(source.nodes, centrifuge.NodeInfo{UID: "3"})
+	w.check()
+	w.check()
+	// Warning logged once when limit exceeded.
+	warnings := logEntries(t, buf, "warn")
+	require.Len(t, warnings, 1)
+	require.Equal(t, "number of nodes exceeds soft limit", warnings[0].Message)
+
+	source.nodes = source.nodes[:2]
+	w.check()
+	require.Len(t, logEntries(t, buf, "info"), 1)
+	source.nodes = append(source.nodes, centrifuge.NodeInfo{UID: "3"})
+	w.check()
+	require.Len(t, logEntries(t, buf, "warn"), 2)
+}
+
+func TestWatcherSoftLimitDisabled(t *testing.T) {
+	buf, restore := captureLogs()
+	defer restore()
+	w, source, _ := testWatcher(t, Config{})
+	for i := 0; i < 100; i++ {
+		source.nodes = append(source.nodes, centrifuge.NodeInfo{UID: string(rune('a' + i))})
+	}
+	w.check()
+	require.Len(t, logEntries(t, buf, "warn"), 0)
+}
+
+func TestWatcherStaleNode(t *testing.T) {
+	buf, restore := captureLogs()
+	defer restore()
+	w, source, now := testWatcher(t, Config{})
+
+	source.nodes = []centrifuge.NodeInfo{{UID: "alive", Uptime: 1}, {UID: "stale", Uptime: 1}}
+	w.check()
+	for i := 0; i < 4; i++ {
+		*now = now.Add(checkInterval)
+		source.nodes[0].Uptime += uint32(checkInterval.Seconds())
+		w.check()
+	}
+	// Reported once.
+	warnings := logEntries(t, buf, "warn")
+	require.Len(t, warnings, 1)
+	require.Equal(t, "node info not updated but node was not evicted", warnings[0].Message)
+	require.Equal(t, "stale", warnings[0].Node)
+
+	// Evicted nodes forgotten.
+	source.nodes = source.nodes[:1]
+	w.check()
+	require.NotContains(t, w.nodes, "stale")
+	require.Contains(t, w.nodes, "alive")
+}
@@ -0,0 +1,41 @@
+package presence
+
+import (
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// ExpireOverride wraps centrifuge.PresenceManager and sets expiration of
+// presence entries according to PresenceExpireInterval of channel namespace.
+// Channels without override use node-wide expiration.
+type ExpireOverride struct {
+	centrifuge.PresenceManager
+	ruleContainer *rule.Container
+}
+
+var _ centrifuge.PresenceManager = (*ExpireOverride)(nil)
+
+// NewExpireOverride creates ExpireOverride.
+func NewExpireOverride(presenceManager centrifuge.PresenceManager, ruleContainer *rule.Container) *ExpireOverride {
+	return &ExpireOverride{
+		PresenceManager: presenceManager,
+		ruleContainer:   ruleContainer,
+	}
+}
+
+// expire returns presence expiration for channel.
+func (o *ExpireOverride) expire(ch string, expire time.Duration) time.Duration {
+	chOpts, found, err := o.ruleContainer.ChannelOptions(ch)
+	if err != nil || !found || chOpts.PresenceExpireInterval <= 0 {
+		return expire
+	}
+	return time.Duration(chOpts.PresenceExpireInterval) * time.Second
+}
+
+// AddPresence ...
+func (o *ExpireOverride) AddPresence(ch string, clientID string, info *centrifuge.ClientInfo, expire time.Duration) error {
+	return o.PresenceManager.AddPresence(ch, clientID, info, o.expire(ch, expire))
+}
@@ -0,0 +1,61 @@
+package presence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+type expireRecorder struct {
+	centrifuge.PresenceManager
+	expires map[string]time.Duration
+}
+
+func (r *expireRecorder) AddPresence(ch string, _ string, _ *centrifuge.ClientInfo, expire time.Duration) error {
+	r.expires[ch] = expire
+	return nil
+}
+
+func TestExpireOverride(t *testing.T) {
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{
+		{Name: "chat", ChannelOptions: rule.ChannelOptions{Presence: true, PresenceExpireInterval: 60}},
+		{Name: "telemetry", ChannelOptions: rule.ChannelOptions{Presence: true, PresenceExpireInterval: 5}},
+		{Name: "other", ChannelOptions: rule.ChannelOptions{Presence: true}},
+	}
+	recorder := &expireRecorder{expires: map[string]time.Duration{}}
+	o := NewExpireOverride(recorder, rule.NewContainer(ruleConfig))
+
+	for _, ch := range []string{"chat:1", "telemetry:1", "other:1", "test", "unknown:1"} {
+		require.NoError(t, o.AddPresence(ch, "client", &centrifuge.ClientInfo{}, 25*time.Second))
+	}
+	require.Equal(t, map[string]time.Duration{
+		"chat:1":      time.Minute,
+		"telemetry:1": 5 * time.Second,
+		// Node-wide expiration used when not overridden.
+		"other:1":   25 * time.Second,
+		"test":      25 * time.Second,
+		"unknown:1": 25 * time.Second,
+	}, recorder.expires)
+}
+
+func TestExpireOverrideNodeTracker(t *testing.T) {
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.PresenceNodeName = true
+	ruleConfig.Namespaces = []rule.ChannelNamespace{
+		{Name: "chat", ChannelOptions: rule.ChannelOptions{Presence: true, PresenceExpireInterval: 60}},
+	}
+	ruleContainer := rule.NewContainer(ruleConfig)
+	recorder := &expireRecorder{expires: map[string]time.Duration{}}
+	o := NewExpireOverride(NewNodeTracker(recorder, "node1", ruleContainer), ruleContainer)
+
+	require.NoError(t, o.AddPresence("chat:1", "client", &centrifuge.ClientInfo{}, 25*time.Second))
+	require.Equal(t, map[string]time.Duration{
+		"chat:1":              time.Minute,
+		NodeChannel("chat:1"): time.Minute,
+	}, recorder.expires)
+}
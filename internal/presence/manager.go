@@ -0,0 +1,131 @@
+// Package presence defines PresenceManager which limits channel presence size.
+package presence
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+type channelPresence struct {
+	// order keeps client IDs in presence from the oldest to the newest.
+	order    *list.List
+	elements map[string]*list.Element
+	// evicted contains clients removed from presence by eviction policy
+	// which are still subscribed to channel.
+	evicted map[string]struct{}
+}
+
+func newChannelPresence() *channelPresence {
+	return &channelPresence{
+		order:    list.New(),
+		elements: make(map[string]*list.Element),
+		evicted:  make(map[string]struct{}),
+	}
+}
+
+func (p *channelPresence) empty() bool {
+	return p.order.Len() == 0 && len(p.evicted) == 0
+}
+
+// Manager wraps centrifuge.PresenceManager and enforces PresenceMaxSize
+// channel option. Manager keeps track of presence entries in process memory
+// so it must only wrap node-local PresenceManager like Memory engine.
+type Manager struct {
+	centrifuge.PresenceManager
+	node          *centrifuge.Node
+	ruleContainer *rule.Container
+
+	mu       sync.Mutex
+	channels map[string]*channelPresence
+}
+
+var _ centrifuge.PresenceManager = (*Manager)(nil)
+
+// New creates Manager.
+func New(n *centrifuge.Node, presenceManager centrifuge.PresenceManager, ruleContainer *rule.Container) *Manager {
+	return &Manager{
+		PresenceManager: presenceManager,
+		node:            n,
+		ruleContainer:   ruleContainer,
+		channels:        make(map[string]*channelPresence),
+	}
+}
+
+// AddPresence ...
+func (m *Manager) AddPresence(ch string, clientID string, info *centrifuge.ClientInfo, expire time.Duration) error {
+	chOpts, found, err := m.ruleContainer.ChannelOptions(ch)
+	if err != nil {
+		return err
+	}
+	if !found || chOpts.PresenceMaxSize <= 0 {
+		return m.PresenceManager.AddPresence(ch, clientID, info, expire)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	p, ok := m.channels[ch]
+	if !ok {
+		p = newChannelPresence()
+		m.channels[ch] = p
+	}
+	if _, ok := p.elements[clientID]; ok {
+		// Presence update of existing entry.
+		return m.PresenceManager.AddPresence(ch, clientID, info, expire)
+	}
+	if _, ok := p.evicted[clientID]; ok {
+		return nil
+	}
+
+	if p.order.Len() >= chOpts.PresenceMaxSize {
+		if chOpts.PresenceEvictionPolicy != rule.PresenceEvictionOldest {
+			// Rejected client won't be visible in presence. Subscribe handler
+			// checks presence size in advance so this only happens when
+			// several clients subscribe concurrently.
+			m.node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelInfo, "channel presence is full", map[string]interface{}{"channel": ch, "client": clientID}))
+			if p.empty() {
+				delete(m.channels, ch)
+			}
+			return nil
+		}
+		oldest := p.order.Front()
+		oldestClientID := oldest.Value.(string)
+		if err := m.PresenceManager.RemovePresence(ch, oldestClientID); err != nil {
+			return err
+		}
+		p.order.Remove(oldest)
+		delete(p.elements, oldestClientID)
+		p.evicted[oldestClientID] = struct{}{}
+	}
+
+	if err := m.PresenceManager.AddPresence(ch, clientID, info, expire); err != nil {
+		if p.empty() {
+			delete(m.channels, ch)
+		}
+		return err
+	}
+	p.elements[clientID] = p.order.PushBack(clientID)
+	return nil
+}
+
+// RemovePresence ...
+func (m *Manager) RemovePresence(ch string, clientID string) error {
+	m.mu.Lock()
+	if p, ok := m.channels[ch]; ok {
+		if el, ok := p.elements[clientID]; ok {
+			p.order.Remove(el)
+			delete(p.elements, clientID)
+		}
+		delete(p.evicted, clientID)
+		if p.empty() {
+			delete(m.channels, ch)
+		}
+	}
+	m.mu.Unlock()
+	return m.PresenceManager.RemovePresence(ch, clientID)
+}
@@ -0,0 +1,87 @@
+package presence
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+func testManager(t *testing.T, policy rule.PresenceEvictionPolicy) *Manager {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	engine, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.Namespaces = []rule.ChannelNamespace{{
+		Name: "limited",
+		ChannelOptions: rule.ChannelOptions{
+			Presence:               true,
+			PresenceMaxSize:        3,
+			PresenceEvictionPolicy: policy,
+		},
+	}}
+	return New(node, engine, rule.NewContainer(ruleConfig))
+}
+
+func addClients(t *testing.T, m *Manager, ch string, from, to int) {
+	for i := from; i < to; i++ {
+		clientID := "client" + strconv.Itoa(i)
+		require.NoError(t, m.AddPresence(ch, clientID, &centrifuge.ClientInfo{ClientID: clientID}, time.Minute))
+	}
+}
+
+func presenceClients(t *testing.T, m *Manager, ch string) []string {
+	presence, err := m.Presence(ch)
+	require.NoError(t, err)
+	var clients []string
+	for i := 0; i < 10; i++ {
+		clientID := "client" + strconv.Itoa(i)
+		if _, ok := presence[clientID]; ok {
+			clients = append(clients, clientID)
+		}
+	}
+	return clients
+}
+
+func TestManagerRejectNew(t *testing.T) {
+	m := testManager(t, rule.PresenceEvictionReject)
+	addClients(t, m, "limited:chat", 0, 5)
+	require.Equal(t, []string{"client0", "client1", "client2"}, presenceClients(t, m, "limited:chat"))
+
+	// Existing entries can be updated.
+	addClients(t, m, "limited:chat", 0, 3)
+	require.Equal(t, []string{"client0", "client1", "client2"}, presenceClients(t, m, "limited:chat"))
+
+	// Place freed after client left.
+	require.NoError(t, m.RemovePresence("limited:chat", "client1"))
+	addClients(t, m, "limited:chat", 4, 5)
+	require.Equal(t, []string{"client0", "client2", "client4"}, presenceClients(t, m, "limited:chat"))
+}
+
+func TestManagerEvictOldest(t *testing.T) {
+	m := testManager(t, rule.PresenceEvictionOldest)
+	addClients(t, m, "limited:chat", 0, 5)
+	require.Equal(t, []string{"client2", "client3", "client4"}, presenceClients(t, m, "limited:chat"))
+
+	// Presence update of evicted clients does not return them back.
+	addClients(t, m, "limited:chat", 0, 5)
+	require.Equal(t, []string{"client2", "client3", "client4"}, presenceClients(t, m, "limited:chat"))
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, m.RemovePresence("limited:chat", "client"+strconv.Itoa(i)))
+	}
+	require.Len(t, presenceClients(t, m, "limited:chat"), 0)
+	require.Len(t, m.channels, 0)
+}
+
+func TestManagerNoLimit(t *testing.T) {
+	m := testManager(t, rule.PresenceEvictionReject)
+	addClients(t, m, "chat", 0, 5)
+	require.Len(t, presenceClients(t, m, "chat"), 5)
+	require.Len(t, m.channels, 0)
+}
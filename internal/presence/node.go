@@ -0,0 +1,69 @@
+package presence
+
+import (
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// nodeChannelPrefix is a prefix of auxiliary presence channels which keep
+// names of nodes owning client connections.
+const nodeChannelPrefix = "__centrifugo_node__:"
+
+// NodeChannel returns auxiliary presence channel where NodeTracker keeps node
+// names for clients in channel ch. Node name of client is a ConnInfo of
+// client entry in this channel.
+func NodeChannel(ch string) string {
+	return nodeChannelPrefix + ch
+}
+
+// NodeTracker wraps centrifuge.PresenceManager and records name of node which
+// owns connection for every presence entry. Node names kept in auxiliary
+// presence channel so they are visible to all nodes when PresenceManager is
+// shared (for example Redis engine). Node names only recorded while
+// PresenceNodeName option is on so option can be turned on with reload.
+type NodeTracker struct {
+	centrifuge.PresenceManager
+	nodeName      string
+	ruleContainer *rule.Container
+}
+
+var _ centrifuge.PresenceManager = (*NodeTracker)(nil)
+
+// NewNodeTracker creates NodeTracker.
+func NewNodeTracker(presenceManager centrifuge.PresenceManager, nodeName string, ruleContainer *rule.Container) *NodeTracker {
+	return &NodeTracker{
+		PresenceManager: presenceManager,
+		nodeName:        nodeName,
+		ruleContainer:   ruleContainer,
+	}
+}
+
+// AddPresence ...
+func (t *NodeTracker) AddPresence(ch string, clientID string, info *centrifuge.ClientInfo, expire time.Duration) error {
+	if err := t.PresenceManager.AddPresence(ch, clientID, info, expire); err != nil {
+		return err
+	}
+	if !t.ruleContainer.Config().PresenceNodeName {
+		return nil
+	}
+	return t.PresenceManager.AddPresence(NodeChannel(ch), clientID, &centrifuge.ClientInfo{
+		UserID:   info.UserID,
+		ClientID: info.ClientID,
+		ConnInfo: []byte(t.nodeName),
+	}, expire)
+}
+
+// RemovePresence ...
+func (t *NodeTracker) RemovePresence(ch string, clientID string) error {
+	if err := t.PresenceManager.RemovePresence(ch, clientID); err != nil {
+		return err
+	}
+	if !t.ruleContainer.Config().PresenceNodeName {
+		// Entries added before option turned off expire.
+		return nil
+	}
+	return t.PresenceManager.RemovePresence(NodeChannel(ch), clientID)
+}
@@ -0,0 +1,90 @@
+package presence
+
+import (
+	"sync"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+type refresherEntry struct {
+	info      *centrifuge.ClientInfo
+	expire    time.Duration
+	updatedAt time.Time
+}
+
+// Refresher wraps centrifuge.PresenceManager and remembers presence entries
+// of connections so they can be refreshed when server observes activity of
+// connection – without waiting for periodic presence update.
+type Refresher struct {
+	centrifuge.PresenceManager
+
+	mu      sync.Mutex
+	clients map[string]map[string]*refresherEntry
+}
+
+var _ centrifuge.PresenceManager = (*Refresher)(nil)
+
+// NewRefresher creates Refresher.
+func NewRefresher(presenceManager centrifuge.PresenceManager) *Refresher {
+	return &Refresher{
+		PresenceManager: presenceManager,
+		clients:         make(map[string]map[string]*refresherEntry),
+	}
+}
+
+// AddPresence ...
+func (r *Refresher) AddPresence(ch string, clientID string, info *centrifuge.ClientInfo, expire time.Duration) error {
+	if err := r.PresenceManager.AddPresence(ch, clientID, info, expire); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	channels, ok := r.clients[clientID]
+	if !ok {
+		channels = make(map[string]*refresherEntry)
+		r.clients[clientID] = channels
+	}
+	channels[ch] = &refresherEntry{info: info, expire: expire, updatedAt: time.Now()}
+	return nil
+}
+
+// RemovePresence ...
+func (r *Refresher) RemovePresence(ch string, clientID string) error {
+	r.mu.Lock()
+	if channels, ok := r.clients[clientID]; ok {
+		delete(channels, ch)
+		if len(channels) == 0 {
+			delete(r.clients, clientID)
+		}
+	}
+	r.mu.Unlock()
+	return r.PresenceManager.RemovePresence(ch, clientID)
+}
+
+// Refresh prolongs presence entries of client. To not load PresenceManager
+// on every client command entry only refreshed when a third of its expire
+// interval passed since last update.
+func (r *Refresher) Refresh(clientID string) error {
+	now := time.Now()
+	type update struct {
+		ch    string
+		entry refresherEntry
+	}
+	var updates []update
+	r.mu.Lock()
+	for ch, entry := range r.clients[clientID] {
+		if now.Sub(entry.updatedAt) < entry.expire/3 {
+			continue
+		}
+		entry.updatedAt = now
+		updates = append(updates, update{ch: ch, entry: *entry})
+	}
+	r.mu.Unlock()
+	for _, u := range updates {
+		if err := r.PresenceManager.AddPresence(u.ch, clientID, u.entry.info, u.entry.expire); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package presence
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+// expiringPresenceManager expires presence entries like Redis engine does.
+type expiringPresenceManager struct {
+	centrifuge.PresenceManager
+	mu      sync.Mutex
+	expires map[string]time.Time
+	numAdds int
+}
+
+func newExpiringPresenceManager() *expiringPresenceManager {
+	return &expiringPresenceManager{expires: make(map[string]time.Time)}
+}
+
+func (m *expiringPresenceManager) AddPresence(ch string, clientID string, _ *centrifuge.ClientInfo, expire time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expires[ch+clientID] = time.Now().Add(expire)
+	m.numAdds++
+	return nil
+}
+
+func (m *expiringPresenceManager) RemovePresence(ch string, clientID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.expires, ch+clientID)
+	return nil
+}
+
+func (m *expiringPresenceManager) present(ch string, clientID string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	expireAt, ok := m.expires[ch+clientID]
+	return ok && time.Now().Before(expireAt)
+}
+
+func TestRefresherKeepsActivePresence(t *testing.T) {
+	m := newExpiringPresenceManager()
+	r := NewRefresher(m)
+	info := &centrifuge.ClientInfo{ClientID: "active"}
+	expire := 300 * time.Millisecond
+	require.NoError(t, r.AddPresence("chat", "active", info, expire))
+	require.NoError(t, r.AddPresence("chat", "idle", &centrifuge.ClientInfo{ClientID: "idle"}, expire))
+
+	// Too early to refresh.
+	require.NoError(t, r.Refresh("active"))
+	require.Equal(t, 2, m.numAdds)
+
+	for i := 0; i < 4; i++ {
+		time.Sleep(expire / 2)
+		require.NoError(t, r.Refresh("active"))
+	}
+	require.True(t, m.present("chat", "active"))
+	require.False(t, m.present("chat", "idle"))
+
+	require.NoError(t, r.RemovePresence("chat", "active"))
+	require.Len(t, r.clients, 1)
+	time.Sleep(expire / 2)
+	require.NoError(t, r.Refresh("active"))
+	require.False(t, m.present("chat", "active"))
+}
@@ -16,6 +16,13 @@ type Config struct {
 	RefreshEndpoint string
 	// RefreshTimeout ...
 	RefreshTimeout time.Duration
+	// RefreshInfoHMACSecretKey when set requires connection info returned
+	// from refresh proxy to be signed with HMAC SHA-256 using this key.
+	RefreshInfoHMACSecretKey string
+	// RefreshInterval when set makes Centrifugo call refresh proxy for
+	// authenticated connections at least with this interval even if their
+	// credentials do not expire, so updated connection info applied.
+	RefreshInterval time.Duration
 	// RPCEndpoint ...
 	RPCEndpoint string
 	// RPCTimeout ...
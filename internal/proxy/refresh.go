@@ -20,6 +20,9 @@ type RefreshCredentials struct {
 	ExpireAt   int64           `json:"expire_at"`
 	Info       json.RawMessage `json:"info"`
 	Base64Info string          `json:"b64info"`
+	// InfoSig is a hex encoded HMAC SHA-256 signature of info. Only checked
+	// when info signing enabled.
+	InfoSig string `json:"info_sig"`
 }
 
 // RefreshReply ...
@@ -2,7 +2,10 @@ package proxy
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"time"
 
@@ -13,6 +16,26 @@ import (
 // RefreshHandlerConfig ...
 type RefreshHandlerConfig struct {
 	Proxy RefreshProxy
+	// InfoHMACSecretKey when set turns on verification of info signature
+	// in refresh proxy response. Info which is not properly signed rejected.
+	InfoHMACSecretKey string
+}
+
+// SignInfo returns hex encoded HMAC SHA-256 signature of connection info.
+func SignInfo(secretKey string, info []byte) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	_, _ = mac.Write(info)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func validInfoSignature(secretKey string, info []byte, signature string) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	_, _ = mac.Write(info)
+	return hmac.Equal(sig, mac.Sum(nil))
 }
 
 // RefreshHandler ...
@@ -95,6 +118,11 @@ func (h *RefreshHandler) Handle(node *centrifuge.Node) RefreshHandlerFunc {
 			}
 		}
 
+		if h.config.InfoHMACSecretKey != "" && !validInfoSignature(h.config.InfoHMACSecretKey, info, credentials.InfoSig) {
+			node.Log(centrifuge.NewLogEntry(centrifuge.LogLevelError, "invalid info signature in refresh reply", map[string]interface{}{"client": client.ID(), "user": client.UserID()}))
+			return centrifuge.RefreshReply{}, centrifuge.DisconnectInvalidToken
+		}
+
 		return centrifuge.RefreshReply{
 			ExpireAt: credentials.ExpireAt,
 			Info:     info,
@@ -1 +1,103 @@
 package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+type testTransport struct{}
+
+func (t *testTransport) Write(_ []byte) error { return nil }
+func (t *testTransport) Name() string         { return "test_transport" }
+func (t *testTransport) Protocol() centrifuge.ProtocolType {
+	return centrifuge.ProtocolTypeJSON
+}
+func (t *testTransport) Encoding() centrifuge.EncodingType {
+	return centrifuge.EncodingTypeJSON
+}
+func (t *testTransport) Close(_ *centrifuge.Disconnect) error { return nil }
+
+type testRefreshProxy struct {
+	reply *RefreshReply
+}
+
+func (p *testRefreshProxy) ProxyRefresh(_ context.Context, _ RefreshRequest) (*RefreshReply, error) {
+	return p.reply, nil
+}
+
+func (p *testRefreshProxy) Protocol() string {
+	return "test"
+}
+
+func newTestClient(t *testing.T) (*centrifuge.Node, *centrifuge.Client) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	require.NoError(t, node.Run())
+	client, _, err := centrifuge.NewClient(context.Background(), node, &testTransport{})
+	require.NoError(t, err)
+	return node, client
+}
+
+func TestRefreshHandlerInfoSignature(t *testing.T) {
+	node, client := newTestClient(t)
+	info := json.RawMessage(`{"name":"Alex"}`)
+
+	refreshProxy := &testRefreshProxy{}
+	handler := NewRefreshHandler(RefreshHandlerConfig{
+		Proxy:             refreshProxy,
+		InfoHMACSecretKey: "secret",
+	}).Handle(node)
+
+	refreshProxy.reply = &RefreshReply{Result: &RefreshCredentials{
+		ExpireAt: 1,
+		Info:     info,
+		InfoSig:  SignInfo("secret", info),
+	}}
+	reply, err := handler(client, centrifuge.RefreshEvent{})
+	require.NoError(t, err)
+	require.Equal(t, []byte(info), reply.Info)
+
+	// Tampered info.
+	refreshProxy.reply = &RefreshReply{Result: &RefreshCredentials{
+		ExpireAt: 1,
+		Info:     json.RawMessage(`{"name":"Admin"}`),
+		InfoSig:  SignInfo("secret", info),
+	}}
+	_, err = handler(client, centrifuge.RefreshEvent{})
+	require.Equal(t, centrifuge.DisconnectInvalidToken, err)
+
+	// Signed with another key.
+	refreshProxy.reply = &RefreshReply{Result: &RefreshCredentials{
+		ExpireAt: 1,
+		Info:     info,
+		InfoSig:  SignInfo("another", info),
+	}}
+	_, err = handler(client, centrifuge.RefreshEvent{})
+	require.Equal(t, centrifuge.DisconnectInvalidToken, err)
+
+	// No signature.
+	refreshProxy.reply = &RefreshReply{Result: &RefreshCredentials{
+		ExpireAt: 1,
+		Info:     info,
+	}}
+	_, err = handler(client, centrifuge.RefreshEvent{})
+	require.Equal(t, centrifuge.DisconnectInvalidToken, err)
+}
+
+func TestRefreshHandlerNoInfoSignature(t *testing.T) {
+	node, client := newTestClient(t)
+	info := json.RawMessage(`{"name":"Alex"}`)
+	handler := NewRefreshHandler(RefreshHandlerConfig{
+		Proxy: &testRefreshProxy{reply: &RefreshReply{Result: &RefreshCredentials{
+			ExpireAt: 1,
+			Info:     info,
+		}}},
+	}).Handle(node)
+	reply, err := handler(client, centrifuge.RefreshEvent{})
+	require.NoError(t, err)
+	require.Equal(t, []byte(info), reply.Info)
+}
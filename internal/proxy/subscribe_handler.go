@@ -91,7 +91,7 @@ func (h *SubscribeHandler) Handle(node *centrifuge.Node) SubscribeHandlerFunc {
 				ChannelInfo: info,
 				Presence:    chOpts.Presence,
 				JoinLeave:   chOpts.JoinLeave,
-				Recover:     chOpts.HistoryRecover,
+				Recover:     chOpts.HistoryRecover || chOpts.SubscribeState,
 			},
 			ClientSideRefresh: true,
 		}, nil
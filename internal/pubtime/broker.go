@@ -0,0 +1,144 @@
+// Package pubtime defines Broker which stores publish time together with
+// publications in engine history, so history can be queried by wall-clock
+// time.
+package pubtime
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// header prepended to data of publications added to history, followed by
+// publish time as Unix milliseconds in 8 bytes big endian. JSON and Protobuf
+// payloads never start with zero byte so header can't be confused with data.
+var header = []byte("\x00pt")
+
+const headerSize = 3 + 8
+
+// Broker wraps centrifuge.Broker. Publish time added to publications which
+// are saved into history stream, so engine (Memory or Redis) keeps it in
+// history alongside publication. Time removed from publications coming from
+// history and from engine PUB/SUB, so clients and history readers get
+// original data.
+//
+// All nodes sharing engine must use Broker: node without it would deliver
+// publications with publish time header to its clients.
+type Broker struct {
+	centrifuge.Broker
+	nowFunc func() time.Time
+}
+
+var _ centrifuge.Broker = (*Broker)(nil)
+
+// New creates Broker.
+func New(broker centrifuge.Broker) *Broker {
+	return &Broker{
+		Broker:  broker,
+		nowFunc: time.Now,
+	}
+}
+
+// Run ...
+func (b *Broker) Run(h centrifuge.BrokerEventHandler) error {
+	return b.Broker.Run(&eventHandler{BrokerEventHandler: h})
+}
+
+// Publish ...
+func (b *Broker) Publish(ch string, data []byte, opts centrifuge.PublishOptions) (centrifuge.StreamPosition, error) {
+	if opts.HistorySize > 0 && opts.HistoryTTL > 0 {
+		data = encode(b.nowFunc(), data)
+	}
+	return b.Broker.Publish(ch, data, opts)
+}
+
+// History ...
+func (b *Broker) History(ch string, filter centrifuge.HistoryFilter) ([]*centrifuge.Publication, centrifuge.StreamPosition, error) {
+	pubs, sp, err := b.Broker.History(ch, filter)
+	if err != nil {
+		return nil, sp, err
+	}
+	result := make([]*centrifuge.Publication, len(pubs))
+	for i, pub := range pubs {
+		result[i] = strip(pub)
+	}
+	return result, sp, nil
+}
+
+// Since returns all publications of channel history stream published at or
+// after provided time. Returns false when boundary can't be found because
+// history contains publications without publish time (added to stream before
+// Broker was used) which can be published after since.
+func (b *Broker) Since(ch string, since time.Time) ([]*centrifuge.Publication, bool, error) {
+	pubs, _, err := b.Broker.History(ch, centrifuge.HistoryFilter{Limit: centrifuge.NoLimit})
+	if err != nil {
+		return nil, false, err
+	}
+	sinceMs := since.UnixNano() / int64(time.Millisecond)
+	// unknown is set when publications without publish time follow latest
+	// publication known to be older than since.
+	unknown := false
+	for i, pub := range pubs {
+		pubTime, _, ok := decode(pub.Data)
+		if !ok {
+			unknown = true
+			continue
+		}
+		if pubTime < sinceMs {
+			unknown = false
+			continue
+		}
+		if unknown {
+			return nil, false, nil
+		}
+		result := make([]*centrifuge.Publication, 0, len(pubs)-i)
+		for _, pub := range pubs[i:] {
+			result = append(result, strip(pub))
+		}
+		return result, true, nil
+	}
+	if unknown {
+		return nil, false, nil
+	}
+	return nil, true, nil
+}
+
+type eventHandler struct {
+	centrifuge.BrokerEventHandler
+}
+
+func (h *eventHandler) HandlePublication(ch string, pub *centrifuge.Publication) error {
+	return h.BrokerEventHandler.HandlePublication(ch, strip(pub))
+}
+
+func encode(t time.Time, data []byte) []byte {
+	buf := make([]byte, headerSize+len(data))
+	copy(buf, header)
+	binary.BigEndian.PutUint64(buf[len(header):], uint64(t.UnixNano()/int64(time.Millisecond)))
+	copy(buf[headerSize:], data)
+	return buf
+}
+
+// decode returns publish time in Unix milliseconds and original data.
+func decode(data []byte) (int64, []byte, bool) {
+	if len(data) < headerSize || !bytes.HasPrefix(data, header) {
+		return 0, data, false
+	}
+	return int64(binary.BigEndian.Uint64(data[len(header):headerSize])), data[headerSize:], true
+}
+
+// strip returns publication without publish time. Publication is copied as
+// engine can keep it in history (Memory engine does).
+func strip(pub *centrifuge.Publication) *centrifuge.Publication {
+	_, data, ok := decode(pub.Data)
+	if !ok {
+		return pub
+	}
+	return &centrifuge.Publication{
+		Offset: pub.Offset,
+		Data:   data,
+		Info:   pub.Info,
+	}
+}
@@ -0,0 +1,195 @@
+package pubtime
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func testNode(t *testing.T, engine func(n *centrifuge.Node) (centrifuge.Engine, error)) (*centrifuge.Node, centrifuge.Engine, *Broker, *testClock) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	e, err := engine(node)
+	require.NoError(t, err)
+	node.SetEngine(e)
+	b := New(e)
+	clock := &testClock{now: time.Unix(1600000000, 0)}
+	b.nowFunc = clock.Now
+	node.SetBroker(b)
+	require.NoError(t, node.Run())
+	return node, e, b, clock
+}
+
+func memoryEngine(n *centrifuge.Node) (centrifuge.Engine, error) {
+	return centrifuge.NewMemoryEngine(n, centrifuge.MemoryEngineConfig{})
+}
+
+func historySince(t *testing.T, b *Broker, ch string, since time.Time) []string {
+	pubs, ok, err := b.Since(ch, since)
+	require.NoError(t, err)
+	require.True(t, ok)
+	var data []string
+	for _, pub := range pubs {
+		data = append(data, string(pub.Data))
+	}
+	return data
+}
+
+func testBrokerSince(t *testing.T, node *centrifuge.Node, b *Broker, clock *testClock, ch string) {
+	start := clock.Now()
+	for _, data := range []string{"1", "2", "3"} {
+		_, err := node.Publish(ch, []byte(data), centrifuge.WithHistory(10, time.Minute))
+		require.NoError(t, err)
+		clock.Add(time.Second)
+	}
+
+	require.Equal(t, []string{"1", "2", "3"}, historySince(t, b, ch, start.Add(-time.Second)))
+	require.Equal(t, []string{"1", "2", "3"}, historySince(t, b, ch, start))
+	require.Equal(t, []string{"2", "3"}, historySince(t, b, ch, start.Add(time.Millisecond)))
+	require.Equal(t, []string{"2", "3"}, historySince(t, b, ch, start.Add(time.Second)))
+	require.Equal(t, []string{"3"}, historySince(t, b, ch, start.Add(1500*time.Millisecond)))
+	require.Nil(t, historySince(t, b, ch, start.Add(3*time.Second)))
+	require.Nil(t, historySince(t, b, ch, start.Add(time.Hour)))
+
+	// Publish time not visible in history.
+	history, err := node.History(ch, centrifuge.WithLimit(centrifuge.NoLimit))
+	require.NoError(t, err)
+	require.Len(t, history.Publications, 3)
+	for i, pub := range history.Publications {
+		require.Equal(t, strconv.Itoa(i+1), string(pub.Data))
+	}
+}
+
+func TestBrokerSince(t *testing.T) {
+	node, _, b, clock := testNode(t, memoryEngine)
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	testBrokerSince(t, node, b, clock, "test")
+}
+
+func TestBrokerSinceRedis(t *testing.T) {
+	conn, err := net.DialTimeout("tcp", "127.0.0.1:6379", time.Second)
+	if err != nil {
+		t.Skip("Redis not available")
+	}
+	_ = conn.Close()
+
+	node, e, b, clock := testNode(t, func(n *centrifuge.Node) (centrifuge.Engine, error) {
+		return centrifuge.NewRedisEngine(n, centrifuge.RedisEngineConfig{
+			Shards: []centrifuge.RedisShardConfig{{Host: "127.0.0.1", Port: 6379, Prefix: "centrifugo_pubtime_test"}},
+		})
+	})
+	defer func() { _ = node.Shutdown(context.Background()) }()
+	ch := "test" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	defer func() { _ = e.RemoveHistory(ch) }()
+	testBrokerSince(t, node, b, clock, ch)
+}
+
+func TestBrokerSinceHistorySize(t *testing.T) {
+	node, _, b, clock := testNode(t, memoryEngine)
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	start := clock.Now()
+	for _, data := range []string{"1", "2", "3"} {
+		_, err := node.Publish("test", []byte(data), centrifuge.WithHistory(2, time.Minute))
+		require.NoError(t, err)
+		clock.Add(time.Second)
+	}
+	require.Equal(t, []string{"2", "3"}, historySince(t, b, "test", start))
+}
+
+func TestBrokerSinceUnknown(t *testing.T) {
+	node, e, b, clock := testNode(t, memoryEngine)
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	// Empty history.
+	pubs, ok, err := b.Since("test", clock.Now())
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, pubs, 0)
+
+	// Publications added to history without Broker have no publish time.
+	publish := func(p centrifuge.Broker, data string) {
+		_, err := p.Publish("test", []byte(data), centrifuge.PublishOptions{HistorySize: 10, HistoryTTL: time.Minute})
+		require.NoError(t, err)
+		clock.Add(time.Second)
+	}
+	publish(e, "1")
+	start := clock.Now()
+	_, ok, err = b.Since("test", start)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	publish(b, "2")
+	publish(b, "3")
+	// Publication 1 is older than publication 2.
+	require.Equal(t, []string{"3"}, historySince(t, b, "test", start.Add(time.Millisecond)))
+	// Publication 1 can be published after since.
+	_, ok, err = b.Since("test", start.Add(-time.Hour))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	publish(e, "4")
+	_, ok, err = b.Since("test", start.Add(time.Hour))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+type testEventHandler struct {
+	centrifuge.BrokerEventHandler
+	pubs []*centrifuge.Publication
+}
+
+func (h *testEventHandler) HandlePublication(_ string, pub *centrifuge.Publication) error {
+	h.pubs = append(h.pubs, pub)
+	return nil
+}
+
+func TestBrokerHandlePublication(t *testing.T) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	e, err := memoryEngine(node)
+	require.NoError(t, err)
+	b := New(e)
+	h := &testEventHandler{}
+	require.NoError(t, b.Run(h))
+
+	_, err = b.Publish("test", []byte("1"), centrifuge.PublishOptions{HistorySize: 10, HistoryTTL: time.Minute})
+	require.NoError(t, err)
+	_, err = b.Publish("test", []byte("2"), centrifuge.PublishOptions{})
+	require.NoError(t, err)
+	require.Len(t, h.pubs, 2)
+	require.Equal(t, "1", string(h.pubs[0].Data))
+	require.Equal(t, uint64(1), h.pubs[0].Offset)
+	require.Equal(t, "2", string(h.pubs[1].Data))
+
+	// Publish time kept in engine history.
+	pubs, _, err := e.History("test", centrifuge.HistoryFilter{Limit: centrifuge.NoLimit})
+	require.NoError(t, err)
+	require.Len(t, pubs, 1)
+	_, data, ok := decode(pubs[0].Data)
+	require.True(t, ok)
+	require.Equal(t, "1", string(data))
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+// +build !linux
+
+package reuseport
+
+import "syscall"
+
+const supported = false
+
+func control(_, _ string, _ syscall.RawConn) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,28 @@
+// Package reuseport allows to create listeners with SO_REUSEPORT socket option.
+package reuseport
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// ErrNotSupported returned when SO_REUSEPORT is not supported on platform.
+var ErrNotSupported = errors.New("SO_REUSEPORT is not supported on this platform")
+
+// Listen announces on the local network address. If reusePort is true then
+// SO_REUSEPORT socket option set on listener so several processes can listen
+// on the same address at the same time. This allows new process to take over
+// listener while old one drains existing connections.
+func Listen(network, addr string, reusePort bool) (net.Listener, error) {
+	if !reusePort {
+		return net.Listen(network, addr)
+	}
+	if !supported {
+		return nil, ErrNotSupported
+	}
+	lc := net.ListenConfig{
+		Control: control,
+	}
+	return lc.Listen(context.Background(), network, addr)
+}
@@ -0,0 +1,49 @@
+package reuseport
+
+import (
+	"net"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenReusePort(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		_, err := Listen("tcp", "127.0.0.1:0", true)
+		require.Equal(t, ErrNotSupported, err)
+		return
+	}
+	ln1, err := Listen("tcp", "127.0.0.1:0", true)
+	require.NoError(t, err)
+	defer func() { _ = ln1.Close() }()
+	addr := ln1.Addr().String()
+
+	// Second listener on the same address – like new process during restart.
+	ln2, err := Listen("tcp", addr, true)
+	require.NoError(t, err)
+	defer func() { _ = ln2.Close() }()
+
+	// Connections accepted by new listener after old one closed.
+	require.NoError(t, ln1.Close())
+	accepted := make(chan struct{})
+	go func() {
+		conn, err := ln2.Accept()
+		if err == nil {
+			_ = conn.Close()
+			close(accepted)
+		}
+	}()
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	_ = conn.Close()
+	<-accepted
+}
+
+func TestListenNoReusePort(t *testing.T) {
+	ln1, err := Listen("tcp", "127.0.0.1:0", false)
+	require.NoError(t, err)
+	defer func() { _ = ln1.Close() }()
+	_, err = Listen("tcp", ln1.Addr().String(), false)
+	require.Error(t, err)
+}
@@ -10,6 +10,18 @@ type ChannelNamespace struct {
 	ChannelOptions `mapstructure:",squash"`
 }
 
+// PresenceEvictionPolicy describes how to behave when channel presence is full.
+type PresenceEvictionPolicy string
+
+const (
+	// PresenceEvictionReject rejects subscriptions of new clients.
+	PresenceEvictionReject PresenceEvictionPolicy = "reject"
+	// PresenceEvictionOldest removes the oldest presence entry to give place
+	// for new one. Evicted client stays subscribed but won't be visible in
+	// channel presence anymore.
+	PresenceEvictionOldest PresenceEvictionPolicy = "evict_oldest"
+)
+
 // ChannelOptions represent channel specific configuration for namespace
 // or global channel options if set on top level of configuration.
 type ChannelOptions struct {
@@ -17,6 +29,18 @@ type ChannelOptions struct {
 	// information about all clients currently subscribed to a channel.
 	Presence bool `mapstructure:"presence" json:"presence"`
 
+	// PresenceMaxSize limits number of entries in channel presence. Zero
+	// value means no limit. At moment limit only enforced by Memory engine.
+	PresenceMaxSize int `mapstructure:"presence_max_size" json:"presence_max_size"`
+
+	// PresenceEvictionPolicy defines what happens when PresenceMaxSize reached.
+	PresenceEvictionPolicy PresenceEvictionPolicy `mapstructure:"presence_eviction_policy" json:"presence_eviction_policy"`
+
+	// PresenceExpireInterval overrides node-wide presence expire interval
+	// (in seconds) for channels in namespace. Zero value means node-wide
+	// interval used.
+	PresenceExpireInterval int `mapstructure:"presence_expire_interval" json:"presence_expire_interval"`
+
 	// JoinLeave turns on join/leave messages for a channel.
 	// When client subscribes on a channel join message sent to all
 	// subscribers in this channel (including current client). When client
@@ -28,6 +52,11 @@ type ChannelOptions struct {
 	// subscribers.
 	JoinLeave bool `mapstructure:"join_leave" json:"join_leave"`
 
+	// JoinLeaveBatchInterval is an interval in seconds to collect join/leave
+	// messages for a channel and send them to subscribers as one batched
+	// publication. Zero value means sending join/leave messages immediately.
+	JoinLeaveBatchInterval int `mapstructure:"join_leave_batch_interval" json:"join_leave_batch_interval"`
+
 	// HistorySize determines max amount of history messages for a channel,
 	// Zero value means no history for channel. Centrifuge history has an
 	// auxiliary role with current Engines – it can not replace your backend
@@ -66,6 +95,15 @@ type ChannelOptions struct {
 	// Turn on this option and use empty string as user ID.
 	Anonymous bool `mapstructure:"anonymous" json:"anonymous"`
 
+	// Insecure applies insecure client mode to channels in namespace only:
+	// anonymous subscribe and publish allowed for them while channels of
+	// other namespaces keep their options. Connections without token are
+	// accepted when at least one namespace is insecure, such connections
+	// limited to channels of insecure namespaces and can not call RPC.
+	// Node-wide ClientInsecure acts as this option turned on for all channels.
+	// Only used for namespaces.
+	Insecure bool `mapstructure:"insecure" json:"insecure"`
+
 	// PresenceDisableForClient prevents presence to be asked by clients.
 	// In this case it's available only over server-side presence call.
 	PresenceDisableForClient bool `mapstructure:"presence_disable_for_client" json:"presence_disable_for_client"`
@@ -81,4 +119,13 @@ type ChannelOptions struct {
 
 	// ProxyPublish turns on proxying publish decision for channels.
 	ProxyPublish bool `mapstructure:"proxy_publish" json:"proxy_publish"`
+
+	// ExpireUnsubscribe makes expiration of subscription token unsubscribe
+	// client from expired channel only instead of closing connection.
+	ExpireUnsubscribe bool `mapstructure:"expire_unsubscribe" json:"expire_unsubscribe"`
+
+	// SubscribeState allows client to receive the latest channel publication
+	// in subscribe reply. Publication attached to reply atomically so that no
+	// publication missed or duplicated around subscribe. Requires history.
+	SubscribeState bool `mapstructure:"subscribe_state" json:"subscribe_state"`
 }
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 )
 
 // Config ...
@@ -26,6 +27,13 @@ type Config struct {
 	// ChannelUserSeparator separates allowed users in user part of channel name.
 	// So you can limit access to channel to limited set of users.
 	ChannelUserSeparator string
+	// ChannelHierarchyDelimiter separates levels of hierarchical channel names.
+	// It's used to find channels of subtree when aggregating presence.
+	ChannelHierarchyDelimiter string
+	// ChannelRootDisable forbids channels without namespace – i.e. top level
+	// channel options are not applied to any channel and all channels must
+	// belong to one of configured namespaces.
+	ChannelRootDisable bool
 	// UserSubscribeToPersonal enables automatic subscribing to personal channel
 	// by user.  Only users with user ID defined will subscribe to personal
 	// channels, anonymous users are ignored.
@@ -46,6 +54,9 @@ type Config struct {
 	// anonymous access and publish allowed for all channels, no connection expire
 	// performed. This can be suitable for demonstration or personal usage.
 	ClientInsecure bool
+	// ClientInsecureUniqueUser in insecure mode sets user ID of connections
+	// without user to client ID so presence information distinguishes them.
+	ClientInsecureUniqueUser bool
 	// ClientAnonymous when set to true, allows connect requests without specifying
 	// a token or setting Credentials in authentication middleware. The resulting
 	// user will have empty string for user ID, meaning user can only subscribe
@@ -55,14 +66,30 @@ type Config struct {
 	// with provided concurrency level. By default commands processed sequentially
 	// one after another.
 	ClientConcurrency int
+	// ClientServerTime when set adds current server time to connect reply data
+	// of JSON clients and enables time RPC method so client SDKs can compensate
+	// clock offset.
+	ClientServerTime bool
+	// ClientPresencePing when set enables presence_ping RPC method which
+	// replies with error when presence of client in channel not maintained.
+	ClientPresencePing bool
+	// ClientSessionTTL when set keeps connection session after client
+	// disconnected so client reconnecting with session token during TTL
+	// restores its credentials and server-side subscriptions and gets channels
+	// to subscribe again. Zero value disables sessions.
+	ClientSessionTTL time.Duration
+	// PresenceNodeName adds name of node which owns client connection to
+	// presence entries returned over server API.
+	PresenceNodeName bool
 }
 
 // DefaultConfig has default config options.
 var DefaultConfig = Config{
-	TokenChannelPrefix:       "$", // so private channel will look like "$gossips"
-	ChannelNamespaceBoundary: ":", // so namespace "public" can be used as "public:news"
-	ChannelUserBoundary:      "#", // so user limited channel is "user#2694" where "2696" is user ID
-	ChannelUserSeparator:     ",", // so several users limited channel is "dialog#2694,3019"
+	TokenChannelPrefix:        "$", // so private channel will look like "$gossips"
+	ChannelNamespaceBoundary:  ":", // so namespace "public" can be used as "public:news"
+	ChannelUserBoundary:       "#", // so user limited channel is "user#2694" where "2696" is user ID
+	ChannelUserSeparator:      ",", // so several users limited channel is "dialog#2694,3019"
+	ChannelHierarchyDelimiter: ":", // so "building:floor" subtree includes "building:floor:1"
 }
 
 func stringInSlice(a string, list []string) bool {
@@ -74,6 +101,44 @@ func stringInSlice(a string, list []string) bool {
 	return false
 }
 
+func validatePresenceLimits(opts ChannelOptions) error {
+	if opts.PresenceMaxSize < 0 {
+		return errors.New("presence max size can not be negative")
+	}
+	switch opts.PresenceEvictionPolicy {
+	case "", PresenceEvictionReject, PresenceEvictionOldest:
+	default:
+		return fmt.Errorf("unknown presence eviction policy: %s", opts.PresenceEvictionPolicy)
+	}
+	return nil
+}
+
+// validateBoundaries checks that channel prefix, boundaries and separator
+// differ so parseChannel can tell channel name parts apart. Empty value
+// disables corresponding channel name part.
+func validateBoundaries(c *Config) error {
+	options := []struct {
+		name  string
+		value string
+	}{
+		{"channel private prefix", c.TokenChannelPrefix},
+		{"channel namespace boundary", c.ChannelNamespaceBoundary},
+		{"channel user boundary", c.ChannelUserBoundary},
+		{"channel user separator", c.ChannelUserSeparator},
+	}
+	for i, option := range options {
+		if option.value == "" {
+			continue
+		}
+		for _, other := range options[i+1:] {
+			if option.value == other.value {
+				return fmt.Errorf("%s and %s can not be the same: %q", option.name, other.name, option.value)
+			}
+		}
+	}
+	return nil
+}
+
 // Validate validates config and returns error if problems found
 func (c *Config) Validate() error {
 	pattern := "^[-a-zA-Z0-9_.]{2,}$"
@@ -82,14 +147,37 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := validateBoundaries(c); err != nil {
+		return err
+	}
+
 	if c.HistoryRecover && (c.HistorySize == 0 || c.HistoryLifetime == 0) {
 		return errors.New("both history size and history lifetime required for history recovery")
 	}
 
+	if c.SubscribeState && (c.HistorySize == 0 || c.HistoryLifetime == 0) {
+		return errors.New("both history size and history lifetime required for subscribe state")
+	}
+
+	if c.JoinLeaveBatchInterval < 0 {
+		return errors.New("join leave batch interval can not be negative")
+	}
+
+	if err := validatePresenceLimits(c.ChannelOptions); err != nil {
+		return err
+	}
+
+	if c.ClientSessionTTL < 0 {
+		return errors.New("client session TTL can not be negative")
+	}
+
 	usePersonalChannel := c.UserSubscribeToPersonal
 	personalChannelNamespace := c.UserPersonalChannelNamespace
 	personalSingleConnection := c.UserPersonalSingleConnection
 	var validPersonalChannelNamespace bool
+	if usePersonalChannel && personalChannelNamespace == "" && c.ChannelRootDisable {
+		return errors.New("namespace for user personal channel required when root channel disabled")
+	}
 	if !usePersonalChannel || personalChannelNamespace == "" {
 		validPersonalChannelNamespace = true
 		if personalSingleConnection && !c.Presence {
@@ -110,6 +198,18 @@ func (c *Config) Validate() error {
 		if n.HistoryRecover && (n.HistorySize == 0 || n.HistoryLifetime == 0) {
 			return fmt.Errorf("namespace %s: both history size and history lifetime required for history recovery", name)
 		}
+		if n.SubscribeState && (n.HistorySize == 0 || n.HistoryLifetime == 0) {
+			return fmt.Errorf("namespace %s: both history size and history lifetime required for subscribe state", name)
+		}
+		if n.JoinLeaveBatchInterval < 0 {
+			return fmt.Errorf("namespace %s: join leave batch interval can not be negative", name)
+		}
+		if n.PresenceExpireInterval < 0 {
+			return fmt.Errorf("namespace %s: presence expire interval can not be negative", name)
+		}
+		if err := validatePresenceLimits(n.ChannelOptions); err != nil {
+			return fmt.Errorf("namespace %s: %w", name, err)
+		}
 		if name == personalChannelNamespace {
 			validPersonalChannelNamespace = true
 			if personalSingleConnection && !n.Presence {
@@ -150,14 +250,63 @@ func (n *Container) Reload(c Config) error {
 	return nil
 }
 
+// channelParts is a channel name split into namespace and allowed users.
+type channelParts struct {
+	// namespace is empty for channels in root namespace.
+	namespace string
+	// userLimited is true when channel contains user boundary.
+	userLimited bool
+	// users allowed to subscribe on user limited channel.
+	users []string
+}
+
+// parseChannel splits channel name into parts. Error returned for channel
+// names which boundaries and separators make ambiguous, parts are still
+// filled as far as channel could be parsed.
+func (c *Config) parseChannel(ch string) (channelParts, error) {
+	var parts channelParts
+	cTrim := strings.TrimPrefix(ch, c.TokenChannelPrefix)
+	if c.ChannelNamespaceBoundary != "" && strings.Contains(cTrim, c.ChannelNamespaceBoundary) {
+		parts.namespace = strings.SplitN(cTrim, c.ChannelNamespaceBoundary, 2)[0]
+		if parts.namespace == "" {
+			return parts, fmt.Errorf("empty namespace before namespace boundary %q", c.ChannelNamespaceBoundary)
+		}
+	}
+	if c.ChannelUserBoundary == "" || !strings.Contains(ch, c.ChannelUserBoundary) {
+		return parts, nil
+	}
+	parts.userLimited = true
+	userParts := strings.Split(ch, c.ChannelUserBoundary)
+	userPart := userParts[len(userParts)-1]
+	if c.ChannelUserSeparator == "" {
+		parts.users = []string{userPart}
+	} else {
+		parts.users = strings.Split(userPart, c.ChannelUserSeparator)
+	}
+	if len(userParts) > 2 {
+		return parts, fmt.Errorf("user boundary %q used more than once", c.ChannelUserBoundary)
+	}
+	for _, user := range parts.users {
+		if user == "" {
+			return parts, errors.New("empty user in allowed users part")
+		}
+	}
+	return parts, nil
+}
+
 // namespaceName returns namespace name from channel if exists.
 func (n *Container) namespaceName(ch string) string {
-	cTrim := strings.TrimPrefix(ch, n.config.TokenChannelPrefix)
-	if n.config.ChannelNamespaceBoundary != "" && strings.Contains(cTrim, n.config.ChannelNamespaceBoundary) {
-		parts := strings.SplitN(cTrim, n.config.ChannelNamespaceBoundary, 2)
-		return parts[0]
-	}
-	return ""
+	parts, _ := n.config.parseChannel(ch)
+	return parts.namespace
+}
+
+// ValidateChannel checks that channel name can be unambiguously split into
+// namespace and allowed users parts.
+func (n *Container) ValidateChannel(ch string) error {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	_, err := n.config.parseChannel(ch)
+	return err
 }
 
 // ChannelOptions returns channel options for channel using current channel config.
@@ -170,6 +319,9 @@ func (n *Container) ChannelOptions(ch string) (ChannelOptions, bool, error) {
 // channelOpts searches for channel options for specified namespace key.
 func (c *Config) channelOpts(namespaceName string) (ChannelOptions, bool, error) {
 	if namespaceName == "" {
+		if c.ChannelRootDisable {
+			return ChannelOptions{}, false, nil
+		}
 		return c.ChannelOptions, true, nil
 	}
 	for _, n := range c.Namespaces {
@@ -180,6 +332,32 @@ func (c *Config) channelOpts(namespaceName string) (ChannelOptions, bool, error)
 	return ChannelOptions{}, false, nil
 }
 
+// InsecureChannel returns whether insecure client mode applies to channel –
+// either node-wide ClientInsecure on or channel namespace is insecure.
+func (n *Container) InsecureChannel(ch string) bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.config.ClientInsecure {
+		return true
+	}
+	namespaceName := n.namespaceName(ch)
+	if namespaceName == "" {
+		return false
+	}
+	chOpts, found, err := n.config.channelOpts(namespaceName)
+	return err == nil && found && chOpts.Insecure
+}
+
+// HasInsecureNamespace returns whether at least one namespace is insecure.
+func (c *Config) HasInsecureNamespace() bool {
+	for _, n := range c.Namespaces {
+		if n.Insecure {
+			return true
+		}
+	}
+	return false
+}
+
 // PersonalChannel returns personal channel for user based on node configuration.
 func (n *Container) PersonalChannel(user string) string {
 	config := n.Config()
@@ -212,33 +390,25 @@ func (n *Container) IsTokenChannel(ch string) bool {
 func (n *Container) IsUserLimited(ch string) bool {
 	n.mu.RLock()
 	defer n.mu.RUnlock()
-	userBoundary := n.config.ChannelUserBoundary
-	if userBoundary == "" {
-		return false
-	}
-	return strings.Contains(ch, userBoundary)
+	parts, _ := n.config.parseChannel(ch)
+	return parts.userLimited
 }
 
 // UserAllowed checks if user can subscribe on channel - as channel
 // can contain special part in the end to indicate which users allowed
-// to subscribe on it.
+// to subscribe on it. Nobody allowed to subscribe on user limited channel
+// with invalid name.
 func (n *Container) UserAllowed(ch string, user string) bool {
 	n.mu.RLock()
 	defer n.mu.RUnlock()
-	userBoundary := n.config.ChannelUserBoundary
-	userSeparator := n.config.ChannelUserSeparator
-	if userBoundary == "" {
+	parts, err := n.config.parseChannel(ch)
+	if !parts.userLimited {
 		return true
 	}
-	if !strings.Contains(ch, userBoundary) {
-		return true
-	}
-	parts := strings.Split(ch, userBoundary)
-	if userSeparator == "" {
-		return parts[len(parts)-1] == user
+	if err != nil {
+		return false
 	}
-	allowedUsers := strings.Split(parts[len(parts)-1], userSeparator)
-	for _, allowedUser := range allowedUsers {
+	for _, allowedUser := range parts.users {
 		if user == allowedUser {
 			return true
 		}
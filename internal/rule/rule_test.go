@@ -13,6 +13,36 @@ func TestChannelNotFound(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestChannelRootDisable(t *testing.T) {
+	c := DefaultConfig
+	c.Namespaces = []ChannelNamespace{{Name: "public"}}
+	container := NewContainer(c)
+	_, found, err := container.ChannelOptions("news")
+	require.NoError(t, err)
+	require.True(t, found)
+
+	c.ChannelRootDisable = true
+	container = NewContainer(c)
+	for _, ch := range []string{"news", "$news", "#42"} {
+		_, found, err = container.ChannelOptions(ch)
+		require.NoError(t, err)
+		require.False(t, found, ch)
+	}
+	_, found, err = container.ChannelOptions("public:news")
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+func TestConfigValidateChannelRootDisablePersonal(t *testing.T) {
+	c := DefaultConfig
+	c.ChannelRootDisable = true
+	c.UserSubscribeToPersonal = true
+	require.Error(t, c.Validate())
+	c.Namespaces = []ChannelNamespace{{Name: "personal"}}
+	c.UserPersonalChannelNamespace = "personal"
+	require.NoError(t, c.Validate())
+}
+
 func TestConfigValidateDefault(t *testing.T) {
 	err := DefaultConfig.Validate()
 	require.NoError(t, err)
@@ -46,6 +76,47 @@ func TestConfigValidateDuplicateNamespaceName(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestConfigValidateSubscribeStateNoHistory(t *testing.T) {
+	c := DefaultConfig
+	c.SubscribeState = true
+	require.Error(t, c.Validate())
+	c.HistorySize = 1
+	c.HistoryLifetime = 60
+	require.NoError(t, c.Validate())
+	c.Namespaces = []ChannelNamespace{
+		{
+			Name:           "name",
+			ChannelOptions: ChannelOptions{SubscribeState: true},
+		},
+	}
+	require.Error(t, c.Validate())
+}
+
+func TestConfigValidateNegativeJoinLeaveBatchInterval(t *testing.T) {
+	c := DefaultConfig
+	c.Namespaces = []ChannelNamespace{
+		{
+			Name:           "name",
+			ChannelOptions: ChannelOptions{JoinLeaveBatchInterval: -1},
+		},
+	}
+	err := c.Validate()
+	require.Error(t, err)
+}
+
+func TestConfigValidateNegativePresenceExpireInterval(t *testing.T) {
+	c := DefaultConfig
+	c.Namespaces = []ChannelNamespace{
+		{
+			Name:           "name",
+			ChannelOptions: ChannelOptions{PresenceExpireInterval: -1},
+		},
+	}
+	require.Error(t, c.Validate())
+	c.Namespaces[0].PresenceExpireInterval = 5
+	require.NoError(t, c.Validate())
+}
+
 func TestConfigValidateNoPersonalNamespace(t *testing.T) {
 	c := DefaultConfig
 	c.Namespaces = []ChannelNamespace{}
@@ -131,6 +202,63 @@ func TestUserAllowed(t *testing.T) {
 	require.False(t, rules.UserAllowed("channel#1,2", "3"))
 }
 
+func TestUserAllowedInvalidChannel(t *testing.T) {
+	rules := NewContainer(DefaultConfig)
+	require.False(t, rules.UserAllowed("channel#", ""))
+	require.False(t, rules.UserAllowed("channel#1,", ""))
+	require.False(t, rules.UserAllowed("channel#2#1", "1"))
+}
+
+func TestValidateChannel(t *testing.T) {
+	rules := NewContainer(DefaultConfig)
+	for _, ch := range []string{"news", "public:news", "$public:news", "#42", "dialog#1,2", "public:dialog#1,2"} {
+		require.NoError(t, rules.ValidateChannel(ch), ch)
+	}
+	for _, ch := range []string{":news", "$:news", "news#", "news#1#2", "news#1,,2", "news#,1", "news#1,"} {
+		require.Error(t, rules.ValidateChannel(ch), ch)
+	}
+
+	// Characters are not reserved when boundary disabled.
+	c := DefaultConfig
+	c.ChannelUserBoundary = ""
+	c.ChannelNamespaceBoundary = ""
+	rules = NewContainer(c)
+	for _, ch := range []string{":news", "news#", "news#1#2"} {
+		require.NoError(t, rules.ValidateChannel(ch), ch)
+	}
+}
+
+func TestConfigValidateBoundaries(t *testing.T) {
+	c := DefaultConfig
+	c.ChannelUserSeparator = ":"
+	require.Error(t, c.Validate())
+
+	c = DefaultConfig
+	c.ChannelUserBoundary = "$"
+	require.Error(t, c.Validate())
+
+	// Disabled parts not compared.
+	c = DefaultConfig
+	c.ChannelUserBoundary = ""
+	c.ChannelUserSeparator = ""
+	require.NoError(t, c.Validate())
+
+	// Same channel parsed according to configured boundaries.
+	c = DefaultConfig
+	c.ChannelNamespaceBoundary = "."
+	c.ChannelUserBoundary = "@"
+	c.ChannelUserSeparator = ";"
+	require.NoError(t, c.Validate())
+	parts, err := c.parseChannel("chat.room@1;2")
+	require.NoError(t, err)
+	require.Equal(t, "chat", parts.namespace)
+	require.Equal(t, []string{"1", "2"}, parts.users)
+	parts, err = DefaultConfig.parseChannel("chat.room@1;2")
+	require.NoError(t, err)
+	require.Equal(t, "", parts.namespace)
+	require.False(t, parts.userLimited)
+}
+
 func TestIsUserLimited(t *testing.T) {
 	rules := NewContainer(DefaultConfig)
 	require.True(t, rules.IsUserLimited("#12"))
@@ -138,3 +266,83 @@ func TestIsUserLimited(t *testing.T) {
 	rules.config.ChannelUserBoundary = ""
 	require.False(t, rules.IsUserLimited("#12"))
 }
+
+func TestContainerReload(t *testing.T) {
+	c := DefaultConfig
+	c.Namespaces = []ChannelNamespace{{Name: "first"}}
+	container := NewContainer(c)
+	_, found, err := container.ChannelOptions("second:test")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	c.Namespaces = []ChannelNamespace{{Name: "first"}, {Name: "second", ChannelOptions: ChannelOptions{Presence: true}}}
+	require.NoError(t, container.Reload(c))
+	_, found, err = container.ChannelOptions("first:test")
+	require.NoError(t, err)
+	require.True(t, found)
+	chOpts, found, err := container.ChannelOptions("second:test")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.True(t, chOpts.Presence)
+}
+
+func TestContainerReloadInvalidKeepsConfig(t *testing.T) {
+	c := DefaultConfig
+	c.Namespaces = []ChannelNamespace{{Name: "first"}}
+	container := NewContainer(c)
+
+	invalid := DefaultConfig
+	invalid.Namespaces = []ChannelNamespace{{Name: "first"}, {Name: "first"}}
+	require.Error(t, container.Reload(invalid))
+	require.Len(t, container.Config().Namespaces, 1)
+	_, found, err := container.ChannelOptions("first:test")
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+func TestContainerReloadConcurrent(t *testing.T) {
+	c := DefaultConfig
+	c.Namespaces = []ChannelNamespace{{Name: "first"}}
+	container := NewContainer(c)
+	reloaded := c
+	reloaded.Namespaces = []ChannelNamespace{{Name: "first"}, {Name: "second"}}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			if i%2 == 0 {
+				_ = container.Reload(reloaded)
+			} else {
+				_ = container.Reload(c)
+			}
+		}
+	}()
+	for i := 0; i < 1000; i++ {
+		_, found, err := container.ChannelOptions("first:test")
+		require.NoError(t, err)
+		require.True(t, found)
+	}
+	<-done
+}
+
+func TestInsecureChannel(t *testing.T) {
+	c := DefaultConfig
+	c.Namespaces = []ChannelNamespace{
+		{Name: "demo", ChannelOptions: ChannelOptions{Insecure: true}},
+		{Name: "private"},
+	}
+	require.True(t, c.HasInsecureNamespace())
+	container := NewContainer(c)
+	require.True(t, container.InsecureChannel("demo:test"))
+	require.False(t, container.InsecureChannel("private:test"))
+	require.False(t, container.InsecureChannel("test"))
+	require.False(t, container.InsecureChannel("unknown:test"))
+
+	c.ClientInsecure = true
+	container = NewContainer(c)
+	require.True(t, container.InsecureChannel("private:test"))
+	require.True(t, container.InsecureChannel("test"))
+
+	require.False(t, DefaultConfig.HasInsecureNamespace())
+}
@@ -0,0 +1,58 @@
+// Package substate defines Broker which limits recovery from the beginning
+// of stream to the latest publication in channels with subscribe state.
+package substate
+
+import (
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// Broker wraps centrifuge.Broker. Subscribe request with recover flag and
+// zero stream position in channel with SubscribeState option enabled
+// recovers only the latest channel publication. As recovery attached to
+// subscribe reply under subscription buffering lock of library the latest
+// publication delivered to client together with subscribe confirmation and
+// concurrent publications are neither missed nor duplicated.
+type Broker struct {
+	centrifuge.Broker
+	ruleContainer *rule.Container
+}
+
+var _ centrifuge.Broker = (*Broker)(nil)
+
+// New creates Broker.
+func New(broker centrifuge.Broker, ruleContainer *rule.Container) *Broker {
+	return &Broker{
+		Broker:        broker,
+		ruleContainer: ruleContainer,
+	}
+}
+
+func (b *Broker) subscribeState(ch string) bool {
+	chOpts, found, err := b.ruleContainer.ChannelOptions(ch)
+	if err != nil || !found {
+		return false
+	}
+	return chOpts.SubscribeState
+}
+
+// History ...
+func (b *Broker) History(ch string, filter centrifuge.HistoryFilter) ([]*centrifuge.Publication, centrifuge.StreamPosition, error) {
+	if filter.Since == nil || filter.Since.Offset != 0 || filter.Since.Epoch != "" || !b.subscribeState(ch) {
+		return b.Broker.History(ch, filter)
+	}
+	_, top, err := b.Broker.History(ch, centrifuge.HistoryFilter{})
+	if err != nil {
+		return nil, centrifuge.StreamPosition{}, err
+	}
+	if top.Offset == 0 {
+		return nil, top, nil
+	}
+	// Publications added after stream top was loaded also returned here,
+	// library merges them with buffered ones.
+	return b.Broker.History(ch, centrifuge.HistoryFilter{
+		Limit: filter.Limit,
+		Since: &centrifuge.StreamPosition{Offset: top.Offset - 1, Epoch: top.Epoch},
+	})
+}
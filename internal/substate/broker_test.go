@@ -0,0 +1,239 @@
+package substate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/centrifugal/protocol"
+	"github.com/stretchr/testify/require"
+)
+
+type testTransport struct {
+	mu     sync.Mutex
+	frames [][]byte
+}
+
+func (t *testTransport) Write(data []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	dataCopy := make([]byte, len(data))
+	copy(dataCopy, data)
+	t.frames = append(t.frames, dataCopy)
+	return nil
+}
+
+func (t *testTransport) Name() string {
+	return "test_transport"
+}
+
+func (t *testTransport) Protocol() centrifuge.ProtocolType {
+	return centrifuge.ProtocolTypeJSON
+}
+
+func (t *testTransport) Encoding() centrifuge.EncodingType {
+	return centrifuge.EncodingTypeJSON
+}
+
+func (t *testTransport) Close(_ *centrifuge.Disconnect) error {
+	return nil
+}
+
+// numbers returns numbers of publications client received in subscribe
+// reply and then in channel pushes.
+func (t *testTransport) numbers(tb testing.TB) (int, []int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var (
+		numbers    []int
+		numInReply int
+	)
+	for _, frame := range t.frames {
+		for _, line := range bytes.Split(frame, []byte("\n")) {
+			if len(line) == 0 {
+				continue
+			}
+			var reply protocol.Reply
+			require.NoError(tb, json.Unmarshal(line, &reply))
+			switch reply.ID {
+			case 2:
+				var res protocol.SubscribeResult
+				require.NoError(tb, json.Unmarshal(reply.Result, &res))
+				for _, pub := range res.Publications {
+					numbers = append(numbers, pubNumber(tb, pub.Data))
+				}
+				numInReply = len(res.Publications)
+			case 0:
+				var push protocol.Push
+				require.NoError(tb, json.Unmarshal(reply.Result, &push))
+				if push.Type != protocol.PushTypePublication {
+					continue
+				}
+				var pub protocol.Publication
+				require.NoError(tb, json.Unmarshal(push.Data, &pub))
+				numbers = append(numbers, pubNumber(tb, pub.Data))
+			}
+		}
+	}
+	return numInReply, numbers
+}
+
+func pubNumber(tb testing.TB, data []byte) int {
+	n, err := strconv.Atoi(string(data))
+	require.NoError(tb, err)
+	return n
+}
+
+func command(tb testing.TB, id uint32, method protocol.MethodType, params interface{}) []byte {
+	var rawParams []byte
+	if params != nil {
+		var err error
+		rawParams, err = json.Marshal(params)
+		require.NoError(tb, err)
+	}
+	data, err := protocol.NewJSONCommandEncoder().Encode(&protocol.Command{
+		ID:     id,
+		Method: method,
+		Params: rawParams,
+	})
+	require.NoError(tb, err)
+	return data
+}
+
+func newTestNode(t *testing.T, chOpts rule.ChannelOptions) (*centrifuge.Node, *Broker) {
+	node, err := centrifuge.New(centrifuge.DefaultConfig)
+	require.NoError(t, err)
+	e, err := centrifuge.NewMemoryEngine(node, centrifuge.MemoryEngineConfig{})
+	require.NoError(t, err)
+	ruleConfig := rule.DefaultConfig
+	ruleConfig.ChannelOptions = chOpts
+	b := New(e, rule.NewContainer(ruleConfig))
+	node.SetEngine(e)
+	node.SetBroker(b)
+	node.OnConnecting(func(_ context.Context, _ centrifuge.ConnectEvent) (centrifuge.ConnectReply, error) {
+		return centrifuge.ConnectReply{Credentials: &centrifuge.Credentials{UserID: "test"}}, nil
+	})
+	node.OnConnect(func(client *centrifuge.Client) {
+		client.OnSubscribe(func(_ centrifuge.SubscribeEvent, cb centrifuge.SubscribeCallback) {
+			cb(centrifuge.SubscribeReply{Options: centrifuge.SubscribeOptions{Recover: true}}, nil)
+		})
+	})
+	require.NoError(t, node.Run())
+	return node, b
+}
+
+var stateOptions = rule.ChannelOptions{
+	HistorySize:     100,
+	HistoryLifetime: 60,
+	SubscribeState:  true,
+}
+
+func publish(t testing.TB, node *centrifuge.Node, n int) {
+	_, err := node.Publish("test", []byte(strconv.Itoa(n)), centrifuge.WithHistory(100, time.Minute))
+	require.NoError(t, err)
+}
+
+func TestBrokerHistory(t *testing.T) {
+	node, b := newTestNode(t, stateOptions)
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	pubs, _, err := b.History("test", centrifuge.HistoryFilter{Limit: -1, Since: &centrifuge.StreamPosition{}})
+	require.NoError(t, err)
+	require.Len(t, pubs, 0)
+
+	for i := 1; i <= 3; i++ {
+		publish(t, node, i)
+	}
+
+	pubs, top, err := b.History("test", centrifuge.HistoryFilter{Limit: -1, Since: &centrifuge.StreamPosition{}})
+	require.NoError(t, err)
+	require.Len(t, pubs, 1)
+	require.Equal(t, []byte("3"), pubs[0].Data)
+	require.Equal(t, uint64(3), top.Offset)
+
+	// Recovery from known position not affected.
+	pubs, _, err = b.History("test", centrifuge.HistoryFilter{Limit: -1, Since: &centrifuge.StreamPosition{Offset: 1, Epoch: top.Epoch}})
+	require.NoError(t, err)
+	require.Len(t, pubs, 2)
+}
+
+func TestBrokerHistoryDisabled(t *testing.T) {
+	node, b := newTestNode(t, rule.ChannelOptions{HistorySize: 100, HistoryLifetime: 60})
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	for i := 1; i <= 3; i++ {
+		publish(t, node, i)
+	}
+	pubs, _, err := b.History("test", centrifuge.HistoryFilter{Limit: -1, Since: &centrifuge.StreamPosition{}})
+	require.NoError(t, err)
+	require.Len(t, pubs, 3)
+}
+
+func TestSubscribeStateConcurrentPublish(t *testing.T) {
+	node, _ := newTestNode(t, stateOptions)
+	defer func() { _ = node.Shutdown(context.Background()) }()
+
+	const (
+		numPublications = 500
+		numClients      = 20
+	)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 1; i <= numPublications; i++ {
+			publish(t, node, i)
+			if i%10 == 0 {
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}()
+
+	transports := make([]*testTransport, 0, numClients)
+	for i := 0; i < numClients; i++ {
+		transport := &testTransport{}
+		client, closeFn, err := centrifuge.NewClient(context.Background(), node, transport)
+		require.NoError(t, err)
+		defer func() { _ = closeFn() }()
+		require.True(t, client.Handle(command(t, 1, protocol.MethodTypeConnect, nil)))
+		require.True(t, client.Handle(command(t, 2, protocol.MethodTypeSubscribe, &protocol.SubscribeRequest{
+			Channel: "test",
+			Recover: true,
+		})))
+		transports = append(transports, transport)
+		time.Sleep(2 * time.Millisecond)
+	}
+	<-done
+
+	for _, transport := range transports {
+		var (
+			numInReply int
+			numbers    []int
+		)
+		deadline := time.Now().Add(3 * time.Second)
+		for time.Now().Before(deadline) {
+			numInReply, numbers = transport.numbers(t)
+			if len(numbers) > 0 && numbers[len(numbers)-1] == numPublications {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		require.NotEmpty(t, numbers)
+		require.Equal(t, numPublications, numbers[len(numbers)-1])
+		if numInReply == 0 {
+			// Client without state in subscribe reply subscribed before
+			// the first publication so must receive all of them.
+			require.Equal(t, 1, numbers[0])
+		}
+		for i := 1; i < len(numbers); i++ {
+			require.Equal(t, numbers[i-1]+1, numbers[i], "publications missed or duplicated: %v", numbers)
+		}
+	}
+}
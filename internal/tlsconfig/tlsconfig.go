@@ -0,0 +1,75 @@
+// Package tlsconfig applies protocol version and cipher suite restrictions
+// to TLS configuration.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+)
+
+// DefaultMinVersion is a minimal TLS version accepted by default.
+const DefaultMinVersion = "1.2"
+
+var versions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// ParseVersion returns TLS version constant by its name like "1.2".
+func ParseVersion(name string) (uint16, error) {
+	version, ok := versions[strings.TrimSpace(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown TLS version: %s", name)
+	}
+	return version, nil
+}
+
+// ParseCipherSuites returns cipher suite IDs by their standard names such as
+// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+func ParseCipherSuites(names []string) ([]uint16, error) {
+	known := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		known[suite.Name] = suite.ID
+	}
+	for _, suite := range tls.InsecureCipherSuites() {
+		known[suite.Name] = suite.ID
+	}
+	ids := make([]uint16, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		id, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown TLS cipher suite: %s", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Apply sets minimal TLS version and allowed cipher suites to tls.Config.
+// Empty minVersion means DefaultMinVersion, empty cipherSuites keeps Go
+// defaults. Note that cipher suites of TLS 1.3 are not configurable.
+func Apply(c *tls.Config, minVersion string, cipherSuites []string) error {
+	if minVersion == "" {
+		minVersion = DefaultMinVersion
+	}
+	version, err := ParseVersion(minVersion)
+	if err != nil {
+		return err
+	}
+	ids, err := ParseCipherSuites(cipherSuites)
+	if err != nil {
+		return err
+	}
+	c.MinVersion = version
+	if len(ids) > 0 {
+		c.CipherSuites = ids
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testCertificate(t *testing.T) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+// serveTLS accepts connections and completes handshakes until listener closed.
+func serveTLS(t *testing.T, c *tls.Config) net.Listener {
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", c)
+	require.NoError(t, err)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+	return ln
+}
+
+func dialTLS(addr string, version uint16) error {
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", addr, &tls.Config{
+		InsecureSkipVerify: true,
+		MinVersion:         version,
+		MaxVersion:         version,
+	})
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func TestParseVersion(t *testing.T) {
+	version, err := ParseVersion("1.3")
+	require.NoError(t, err)
+	require.Equal(t, uint16(tls.VersionTLS13), version)
+	_, err = ParseVersion("1.4")
+	require.Error(t, err)
+}
+
+func TestParseCipherSuites(t *testing.T) {
+	ids, err := ParseCipherSuites([]string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", " TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384", ""})
+	require.NoError(t, err)
+	require.Equal(t, []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384}, ids)
+	_, err = ParseCipherSuites([]string{"TLS_UNKNOWN"})
+	require.Error(t, err)
+}
+
+func TestApply(t *testing.T) {
+	c := &tls.Config{}
+	require.NoError(t, Apply(c, "", nil))
+	require.Equal(t, uint16(tls.VersionTLS12), c.MinVersion)
+	require.Nil(t, c.CipherSuites)
+
+	require.Error(t, Apply(&tls.Config{}, "1.2", []string{"TLS_UNKNOWN"}))
+	require.Error(t, Apply(&tls.Config{}, "2.0", nil))
+}
+
+func TestApplyRefusesOldClient(t *testing.T) {
+	c := &tls.Config{Certificates: []tls.Certificate{testCertificate(t)}}
+	require.NoError(t, Apply(c, "1.2", []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"}))
+	ln := serveTLS(t, c)
+	defer func() { _ = ln.Close() }()
+	addr := ln.Addr().String()
+
+	require.Error(t, dialTLS(addr, tls.VersionTLS11))
+	require.NoError(t, dialTLS(addr, tls.VersionTLS12))
+	require.NoError(t, dialTLS(addr, tls.VersionTLS13))
+}
+
+func TestApplyAllowsOldClient(t *testing.T) {
+	c := &tls.Config{Certificates: []tls.Certificate{testCertificate(t)}}
+	require.NoError(t, Apply(c, "1.1", nil))
+	ln := serveTLS(t, c)
+	defer func() { _ = ln.Close() }()
+
+	require.NoError(t, dialTLS(ln.Addr().String(), tls.VersionTLS11))
+}
@@ -39,7 +39,10 @@ var jsonConfigTemplate = `{
   "admin_password": "{{.AdminPassword}}",
   "admin_secret": "{{.AdminSecret}}",
   "api_key": "{{.APIKey}}",
-  "allowed_origins": []
+  "allowed_origins": []{{if .Namespaces}},
+  "namespaces": [{{range $i, $name := .Namespaces}}{{if $i}},{{end}}
+    {"name": {{printf "%q" $name}}}{{end}}
+  ]{{end}}
 }
 `
 
@@ -49,6 +52,11 @@ admin_password = "{{.AdminPassword}}"
 admin_secret = "{{.AdminSecret}}"
 api_key = "{{.APIKey}}"
 allowed_origins = []
+{{- range .Namespaces}}
+
+[[namespaces]]
+name = {{printf "%q" .}}
+{{- end}}
 `
 
 var yamlConfigTemplate = `v3_use_offset: true
@@ -57,10 +65,17 @@ admin_password: {{.AdminPassword}}
 admin_secret: {{.AdminSecret}}
 api_key: {{.APIKey}}
 allowed_origins: []
+{{- if .Namespaces}}
+namespaces:
+{{- range .Namespaces}}
+  - name: {{printf "%q" .}}
+{{- end}}
+{{- end}}
 `
 
-// GenerateConfig generates configuration file at provided path.
-func GenerateConfig(f string) error {
+// GenerateConfig generates configuration file at provided path. Config
+// contains channel namespace for every name in namespaces.
+func GenerateConfig(f string, namespaces []string) error {
 	exists, err := pathExists(f)
 	if err != nil {
 		return err
@@ -100,11 +115,13 @@ func GenerateConfig(f string) error {
 		AdminPassword string
 		AdminSecret   string
 		APIKey        string
+		Namespaces    []string
 	}{
 		uuid.New().String(),
 		uuid.New().String(),
 		uuid.New().String(),
 		uuid.New().String(),
+		namespaces,
 	})
 
 	return ioutil.WriteFile(f, output.Bytes(), 0644)
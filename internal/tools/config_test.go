@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/FZambia/viper-lite"
+	"github.com/stretchr/testify/require"
+)
+
+func readGeneratedConfig(t *testing.T, f string) *viper.Viper {
+	v := viper.New()
+	v.SetConfigFile(f)
+	require.NoError(t, v.ReadInConfig())
+	return v
+}
+
+func TestGenerateConfigNamespaces(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrifugo_genconfig")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	names := []string{"chat", "telemetry", "public.news"}
+	for _, ext := range []string{"json", "toml", "yaml"} {
+		f := filepath.Join(dir, "config."+ext)
+		require.NoError(t, GenerateConfig(f, names))
+
+		v := readGeneratedConfig(t, f)
+		require.NotEmpty(t, v.GetString("token_hmac_secret_key"), ext)
+		var namespaces []rule.ChannelNamespace
+		require.NoError(t, v.UnmarshalKey("namespaces", &namespaces), ext)
+		require.Len(t, namespaces, 3, ext)
+		for i, name := range names {
+			require.Equal(t, name, namespaces[i].Name, ext)
+		}
+	}
+}
+
+func TestGenerateConfigNoNamespaces(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrifugo_genconfig")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	for _, ext := range []string{"json", "toml", "yaml"} {
+		f := filepath.Join(dir, "config."+ext)
+		require.NoError(t, GenerateConfig(f, nil))
+		v := readGeneratedConfig(t, f)
+		require.NotEmpty(t, v.GetString("api_key"), ext)
+		require.False(t, v.IsSet("namespaces"), ext)
+	}
+}
+
+func TestGenerateConfigExists(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrifugo_genconfig")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	f := filepath.Join(dir, "config.json")
+	require.NoError(t, GenerateConfig(f, nil))
+	require.Error(t, GenerateConfig(f, nil))
+	require.Error(t, GenerateConfig(filepath.Join(dir, "config.txt"), nil))
+}
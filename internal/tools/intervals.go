@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+)
+
+// CheckNodeIntervals checks relationships between node intervals built from
+// configuration options so node never starts with timers which fire in a
+// loop or presence which expires between updates. Zero value of delays
+// disables corresponding check in centrifuge so only negative values
+// rejected for them. Errors contain configuration option name.
+func CheckNodeIntervals(c centrifuge.Config) error {
+	if c.ClientPresenceUpdateInterval <= 0 {
+		return fmt.Errorf("client_presence_ping_interval must be positive, got %s", c.ClientPresenceUpdateInterval)
+	}
+	if c.ClientPresenceExpireInterval <= 0 {
+		return fmt.Errorf("client_presence_expire_interval must be positive, got %s", c.ClientPresenceExpireInterval)
+	}
+	if c.ClientPresenceExpireInterval <= c.ClientPresenceUpdateInterval {
+		return fmt.Errorf("client_presence_expire_interval (%s) must be greater than client_presence_ping_interval (%s)", c.ClientPresenceExpireInterval, c.ClientPresenceUpdateInterval)
+	}
+	delays := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"client_expired_close_delay", c.ClientExpiredCloseDelay},
+		{"client_expired_sub_close_delay", c.ClientExpiredSubCloseDelay},
+		{"client_stale_close_delay", c.ClientStaleCloseDelay},
+		{"client_channel_position_check_delay", c.ClientChannelPositionCheckDelay},
+		{"node_info_metrics_aggregate_interval", c.NodeInfoMetricsAggregateInterval},
+	}
+	for _, d := range delays {
+		if d.value < 0 {
+			return fmt.Errorf("%s can not be negative, got %s", d.name, d.value)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifuge"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckNodeIntervals(t *testing.T) {
+	require.NoError(t, CheckNodeIntervals(centrifuge.DefaultConfig))
+
+	c := centrifuge.DefaultConfig
+	c.ClientExpiredCloseDelay = 0
+	c.ClientExpiredSubCloseDelay = 0
+	c.ClientStaleCloseDelay = 0
+	c.ClientChannelPositionCheckDelay = 0
+	c.NodeInfoMetricsAggregateInterval = 0
+	require.NoError(t, CheckNodeIntervals(c))
+
+	c = centrifuge.DefaultConfig
+	c.ClientPresenceUpdateInterval = 59 * time.Second
+	c.ClientPresenceExpireInterval = 60 * time.Second
+	require.NoError(t, CheckNodeIntervals(c))
+}
+
+func TestCheckNodeIntervalsInvalid(t *testing.T) {
+	testCases := []struct {
+		option string
+		modify func(c *centrifuge.Config)
+	}{
+		{"client_presence_ping_interval", func(c *centrifuge.Config) { c.ClientPresenceUpdateInterval = 0 }},
+		{"client_presence_ping_interval", func(c *centrifuge.Config) { c.ClientPresenceUpdateInterval = -time.Second }},
+		{"client_presence_expire_interval", func(c *centrifuge.Config) { c.ClientPresenceExpireInterval = 0 }},
+		{"client_presence_expire_interval", func(c *centrifuge.Config) { c.ClientPresenceExpireInterval = c.ClientPresenceUpdateInterval }},
+		{"client_presence_expire_interval", func(c *centrifuge.Config) { c.ClientPresenceExpireInterval = c.ClientPresenceUpdateInterval - time.Second }},
+		{"client_expired_close_delay", func(c *centrifuge.Config) { c.ClientExpiredCloseDelay = -time.Second }},
+		{"client_expired_sub_close_delay", func(c *centrifuge.Config) { c.ClientExpiredSubCloseDelay = -time.Second }},
+		{"client_stale_close_delay", func(c *centrifuge.Config) { c.ClientStaleCloseDelay = -time.Second }},
+		{"client_channel_position_check_delay", func(c *centrifuge.Config) { c.ClientChannelPositionCheckDelay = -time.Second }},
+		{"node_info_metrics_aggregate_interval", func(c *centrifuge.Config) { c.NodeInfoMetricsAggregateInterval = -time.Second }},
+	}
+	for _, tc := range testCases {
+		c := centrifuge.DefaultConfig
+		tc.modify(&c)
+		err := CheckNodeIntervals(c)
+		require.Error(t, err, tc.option)
+		require.Contains(t, err.Error(), tc.option)
+	}
+}
@@ -0,0 +1,30 @@
+package tools
+
+import (
+	"sort"
+)
+
+// DuplicateSecrets finds non-empty secret values shared by several configuration
+// options. Keys of secrets map are option names. It returns groups of option
+// names which share the same value, each group and groups list are sorted.
+func DuplicateSecrets(secrets map[string]string) [][]string {
+	byValue := map[string][]string{}
+	for name, value := range secrets {
+		if value == "" {
+			continue
+		}
+		byValue[value] = append(byValue[value], name)
+	}
+	var groups [][]string
+	for _, names := range byValue {
+		if len(names) < 2 {
+			continue
+		}
+		sort.Strings(names)
+		groups = append(groups, names)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0] < groups[j][0]
+	})
+	return groups
+}
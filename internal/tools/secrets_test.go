@@ -0,0 +1,28 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuplicateSecrets(t *testing.T) {
+	require.Len(t, DuplicateSecrets(map[string]string{
+		"token_hmac_secret_key": "secret1",
+		"admin_secret":          "secret2",
+		"api_key":               "",
+		"admin_password":        "",
+	}), 0)
+
+	groups := DuplicateSecrets(map[string]string{
+		"token_hmac_secret_key": "secret",
+		"admin_secret":          "secret",
+		"api_key":               "key",
+		"admin_password":        "key",
+		"admin_users.alice":     "password",
+	})
+	require.Equal(t, [][]string{
+		{"admin_password", "api_key"},
+		{"admin_secret", "token_hmac_secret_key"},
+	}, groups)
+}
@@ -12,10 +12,14 @@ import (
 
 // GenerateToken generates sample JWT for user.
 func GenerateToken(config jwtverify.VerifierConfig, user string, ttlSeconds int64) (string, error) {
-	if config.HMACSecretKey == "" {
+	secretKey := config.ConnectHMACSecretKey
+	if secretKey == "" {
+		secretKey = config.HMACSecretKey
+	}
+	if secretKey == "" {
 		return "", fmt.Errorf("no HMAC secret key set")
 	}
-	signer, _ := jwt.NewSignerHS(jwt.HS256, []byte(config.HMACSecretKey))
+	signer, _ := jwt.NewSignerHS(jwt.HS256, []byte(secretKey))
 	builder := jwt.NewBuilder(signer)
 	token, err := builder.Build(jwt.StandardClaims{
 		Subject:   user,
@@ -0,0 +1,157 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/pelletier/go-toml"
+	"gopkg.in/yaml.v2"
+)
+
+// DeprecatedOption is a configuration option replaced by another one with
+// the same meaning.
+type DeprecatedOption struct {
+	Name        string
+	Replacement string
+}
+
+// DeprecatedOptions renamed by UpgradeConfig.
+var DeprecatedOptions = []DeprecatedOption{
+	{Name: "secret", Replacement: "token_hmac_secret_key"},
+	{Name: "client_ping_interval", Replacement: "websocket_ping_interval"},
+	{Name: "client_message_write_timeout", Replacement: "websocket_write_timeout"},
+	{Name: "client_request_max_size", Replacement: "websocket_message_size_limit"},
+}
+
+// ErrNothingToUpgrade returned by UpgradeConfig when configuration does not
+// contain deprecated options.
+var ErrNothingToUpgrade = errors.New("no deprecated options found in configuration")
+
+// configDocument is a top level of configuration file.
+type configDocument interface {
+	has(key string) bool
+	get(key string) interface{}
+	set(key string, value interface{})
+	delete(key string)
+	encode() ([]byte, error)
+}
+
+// UpgradeConfig renames deprecated top level options of configuration file
+// content in format ext (json, toml, yaml or yml) to their replacements and
+// returns new content with names of renamed options. Configuration which
+// sets both deprecated option and its replacement is not upgraded as it's
+// not clear which value is intended.
+func UpgradeConfig(data []byte, ext string) ([]byte, []string, error) {
+	doc, err := decodeConfig(data, ext)
+	if err != nil {
+		return nil, nil, err
+	}
+	var renamed []string
+	for _, option := range DeprecatedOptions {
+		if !doc.has(option.Name) {
+			continue
+		}
+		if doc.has(option.Replacement) {
+			return nil, nil, fmt.Errorf("both %s and %s set, remove %s manually", option.Name, option.Replacement, option.Name)
+		}
+		doc.set(option.Replacement, doc.get(option.Name))
+		doc.delete(option.Name)
+		renamed = append(renamed, option.Name)
+	}
+	if len(renamed) == 0 {
+		return nil, nil, ErrNothingToUpgrade
+	}
+	result, err := doc.encode()
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, renamed, nil
+}
+
+func decodeConfig(data []byte, ext string) (configDocument, error) {
+	switch ext {
+	case "json":
+		m := map[string]interface{}{}
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&m); err != nil {
+			return nil, err
+		}
+		return &mapDocument{m: m, encodeFunc: func(v interface{}) ([]byte, error) {
+			data, err := json.MarshalIndent(v, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			return append(data, '\n'), nil
+		}}, nil
+	case "yaml", "yml":
+		m := map[string]interface{}{}
+		if err := yaml.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		return &mapDocument{m: m, encodeFunc: yaml.Marshal}, nil
+	case "toml":
+		tree, err := toml.LoadBytes(data)
+		if err != nil {
+			return nil, err
+		}
+		return &tomlDocument{tree: tree}, nil
+	}
+	return nil, errors.New("unsupported config file extension: " + ext)
+}
+
+type mapDocument struct {
+	m          map[string]interface{}
+	encodeFunc func(v interface{}) ([]byte, error)
+}
+
+func (d *mapDocument) has(key string) bool {
+	_, ok := d.m[key]
+	return ok
+}
+
+func (d *mapDocument) get(key string) interface{} {
+	return d.m[key]
+}
+
+func (d *mapDocument) set(key string, value interface{}) {
+	d.m[key] = value
+}
+
+func (d *mapDocument) delete(key string) {
+	delete(d.m, key)
+}
+
+func (d *mapDocument) encode() ([]byte, error) {
+	return d.encodeFunc(d.m)
+}
+
+type tomlDocument struct {
+	tree *toml.Tree
+}
+
+func (d *tomlDocument) has(key string) bool {
+	return d.tree.Has(key)
+}
+
+func (d *tomlDocument) get(key string) interface{} {
+	return d.tree.Get(key)
+}
+
+func (d *tomlDocument) set(key string, value interface{}) {
+	d.tree.Set(key, value)
+}
+
+func (d *tomlDocument) delete(key string) {
+	_ = d.tree.Delete(key)
+}
+
+func (d *tomlDocument) encode() ([]byte, error) {
+	s, err := d.tree.ToTomlString()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/centrifugal/centrifugo/internal/rule"
+
+	"github.com/stretchr/testify/require"
+)
+
+var legacyConfigs = map[string]string{
+	"json": `{
+  "secret": "token_secret",
+  "client_ping_interval": 10,
+  "api_key": "api_key",
+  "namespaces": [{"name": "chat", "presence": true}]
+}`,
+	"yaml": `secret: token_secret
+client_ping_interval: 10
+api_key: api_key
+namespaces:
+  - name: chat
+    presence: true
+`,
+	"toml": `secret = "token_secret"
+client_ping_interval = 10
+api_key = "api_key"
+
+[[namespaces]]
+name = "chat"
+presence = true
+`,
+}
+
+func TestUpgradeConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrifugo_upgradeconfig")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	for ext, legacy := range legacyConfigs {
+		data, renamed, err := UpgradeConfig([]byte(legacy), ext)
+		require.NoError(t, err, ext)
+		require.Equal(t, []string{"secret", "client_ping_interval"}, renamed, ext)
+
+		f := filepath.Join(dir, "config."+ext)
+		require.NoError(t, ioutil.WriteFile(f, data, 0644))
+		v := readGeneratedConfig(t, f)
+		require.False(t, v.IsSet("secret"), ext)
+		require.False(t, v.IsSet("client_ping_interval"), ext)
+		require.Equal(t, "token_secret", v.GetString("token_hmac_secret_key"), ext)
+		require.Equal(t, 10, v.GetInt("websocket_ping_interval"), ext)
+		require.Equal(t, "api_key", v.GetString("api_key"), ext)
+
+		var namespaces []rule.ChannelNamespace
+		require.NoError(t, v.UnmarshalKey("namespaces", &namespaces), ext)
+		require.Len(t, namespaces, 1, ext)
+		require.Equal(t, "chat", namespaces[0].Name, ext)
+		require.True(t, namespaces[0].Presence, ext)
+		c := rule.DefaultConfig
+		c.Namespaces = namespaces
+		require.NoError(t, c.Validate(), ext)
+
+		// Upgraded configuration not upgraded again.
+		_, _, err = UpgradeConfig(data, ext)
+		require.Equal(t, ErrNothingToUpgrade, err, ext)
+	}
+}
+
+func TestUpgradeConfigConflict(t *testing.T) {
+	_, _, err := UpgradeConfig([]byte(`{"secret": "old", "token_hmac_secret_key": "new"}`), "json")
+	require.Error(t, err)
+	require.NotEqual(t, ErrNothingToUpgrade, err)
+}
+
+func TestUpgradeConfigInvalid(t *testing.T) {
+	_, _, err := UpgradeConfig([]byte(`{"secret": `), "json")
+	require.Error(t, err)
+	_, _, err = UpgradeConfig([]byte(`secret: old`), "ini")
+	require.Error(t, err)
+}
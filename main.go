@@ -1,7 +1,10 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
 	"crypto/tls"
 	"encoding/json"
 	"errors"
@@ -25,16 +28,31 @@ import (
 	"github.com/centrifugal/centrifugo/internal/admin"
 	"github.com/centrifugal/centrifugo/internal/api"
 	"github.com/centrifugal/centrifugo/internal/client"
+	"github.com/centrifugal/centrifugo/internal/configdir"
+	"github.com/centrifugal/centrifugo/internal/configdump"
+	"github.com/centrifugal/centrifugo/internal/configinfo"
+	"github.com/centrifugal/centrifugo/internal/controlmsg"
+	"github.com/centrifugal/centrifugo/internal/enginerouter"
+	"github.com/centrifugal/centrifugo/internal/enginestats"
+	"github.com/centrifugal/centrifugo/internal/fallback"
 	"github.com/centrifugal/centrifugo/internal/health"
+	"github.com/centrifugal/centrifugo/internal/joinleave"
 	"github.com/centrifugal/centrifugo/internal/jwtutils"
 	"github.com/centrifugal/centrifugo/internal/jwtverify"
 	"github.com/centrifugal/centrifugo/internal/logutils"
+	"github.com/centrifugal/centrifugo/internal/maintenance"
 	"github.com/centrifugal/centrifugo/internal/metrics/graphite"
 	"github.com/centrifugal/centrifugo/internal/middleware"
 	"github.com/centrifugal/centrifugo/internal/natsbroker"
+	"github.com/centrifugal/centrifugo/internal/nodewatch"
 	"github.com/centrifugal/centrifugo/internal/origin"
+	"github.com/centrifugal/centrifugo/internal/presence"
 	"github.com/centrifugal/centrifugo/internal/proxy"
+	"github.com/centrifugal/centrifugo/internal/pubtime"
+	"github.com/centrifugal/centrifugo/internal/reuseport"
 	"github.com/centrifugal/centrifugo/internal/rule"
+	"github.com/centrifugal/centrifugo/internal/substate"
+	"github.com/centrifugal/centrifugo/internal/tlsconfig"
 	"github.com/centrifugal/centrifugo/internal/tools"
 	"github.com/centrifugal/centrifugo/internal/webui"
 
@@ -60,11 +78,15 @@ var configDefaults = map[string]interface{}{
 	"engine":                               "memory",
 	"broker":                               "",
 	"name":                                 "",
+	"config_dir":                           "",
 	"secret":                               "",
 	"token_hmac_secret_key":                "",
+	"token_hmac_secondary_secret_keys":     []string{},
 	"token_jwks_public_endpoint":           "",
 	"token_rsa_public_key":                 "",
 	"token_ecdsa_public_key":               "",
+	"connect_token_hmac_secret_key":        "",
+	"subscribe_token_hmac_secret_key":      "",
 	"server_side":                          false,
 	"publish":                              false,
 	"subscribe_to_publish":                 false,
@@ -77,8 +99,11 @@ var configDefaults = map[string]interface{}{
 	"history_disable_for_client":           false,
 	"proxy_subscribe":                      false,
 	"proxy_publish":                        false,
+	"expire_unsubscribe":                   false,
+	"subscribe_state":                      false,
 	"node_info_metrics_aggregate_interval": 60,
 	"client_anonymous":                     false,
+	"client_insecure_unique_user":          false,
 	"client_expired_close_delay":           25,
 	"client_expired_sub_close_delay":       25,
 	"client_stale_close_delay":             25,
@@ -86,6 +111,14 @@ var configDefaults = map[string]interface{}{
 	"client_queue_max_size":                10485760, // 10MB
 	"client_presence_ping_interval":        25,
 	"client_presence_expire_interval":      60,
+	"client_presence_refresh_on_activity":  false,
+	"channel_root_disable":                 false,
+	"api_response_envelope":                "wrapped",
+	"api_accept_form":                      false,
+	"node_info_soft_limit":                 0,
+	"maintenance":                          false,
+	"maintenance_message":                  "",
+	"maintenance_block_subscribe":          false,
 	"client_user_connection_limit":         0,
 	"client_channel_position_check_delay":  40,
 	"channel_max_length":                   255,
@@ -93,6 +126,7 @@ var configDefaults = map[string]interface{}{
 	"channel_namespace_boundary":           ":",
 	"channel_user_boundary":                "#",
 	"channel_user_separator":               ",",
+	"channel_hierarchy_delimiter":          ":",
 	"user_subscribe_to_personal":           false,
 	"user_personal_channel_namespace":      "",
 	"user_personal_single_connection":      false,
@@ -105,6 +139,8 @@ var configDefaults = map[string]interface{}{
 	"admin_secret":                         "",
 	"admin_insecure":                       false,
 	"admin_web_path":                       "",
+	"admin_metrics_interval":               1,
+	"admin_metrics_window":                 60,
 	"sockjs_url":                           "https://cdn.jsdelivr.net/npm/sockjs-client@1/dist/sockjs.min.js",
 	"sockjs_heartbeat_delay":               25,
 	"websocket_compression":                false,
@@ -127,6 +163,8 @@ var configDefaults = map[string]interface{}{
 	"tls_autocert_server_name":             "",
 	"tls_autocert_http":                    false,
 	"tls_autocert_http_addr":               ":80",
+	"tls_min_version":                      "1.2",
+	"tls_cipher_suites":                    "",
 	"redis_prefix":                         "centrifugo",
 	"redis_connect_timeout":                1, // TODO v3: make all timeouts float.
 	"redis_read_timeout":                   5,
@@ -163,69 +201,124 @@ var configDefaults = map[string]interface{}{
 	"proxy_rpc_timeout":                    1,
 	"proxy_refresh_endpoint":               "",
 	"proxy_refresh_timeout":                1,
+	"proxy_refresh_sign_info":              false,
+	"proxy_refresh_interval":               0,
+	"broadcast_workers":                    0,
+	"overload_connection_capacity":         0,
+	"overload_reconnect_delay_min":         1000,
+	"overload_reconnect_delay_max":         30000,
+	"client_server_time":                   false,
+	"client_presence_ping":                 false,
+	"node_channel_limit":                   0,
+	"client_session_ttl":                   0,
+	"forbid_secret_reuse":                  false,
 	"memory_history_meta_ttl":              0,
 	"redis_history_meta_ttl":               0,
 	"v3_use_offset":                        false, // TODO v3: remove.
+	"publish_data_validation":              "none",
+	"engine_publish_failure_policy":        "fail",
+	"engine_slow_log_threshold":            0.0,
+	"control_unknown_policy":               "ignore",
+	"client_addr":                          "",
+	"api_addr":                             "",
+	"admin_addr":                           "",
+	"join_leave_batch_interval":            0,
+	"presence_max_size":                    0,
+	"presence_eviction_policy":             "reject",
+	"presence_node_name":                   false,
+	"reuse_port":                           false,
+
+	"connect_token_hmac_secondary_secret_keys":   []string{},
+	"subscribe_token_hmac_secondary_secret_keys": []string{},
+}
+
+// bindEnvs lists options explicitly bound to CENTRIFUGO_<OPTION_NAME> env
+// variables so they show up in AllSettings even when not set anywhere else.
+var bindEnvs = []string{
+	"address", "admin", "admin_external", "admin_insecure", "admin_password",
+	"admin_secret", "admin_web_path", "anonymous", "api_insecure", "api_key",
+	"channel_max_length", "channel_namespace_boundary", "channel_private_prefix",
+	"channel_user_boundary", "channel_user_separator", "client_anonymous",
+	"client_channel_limit", "client_channel_position_check_delay", "client_user_connection_limit", "gomaxprocs",
+	"client_expired_close_delay", "client_expired_sub_close_delay",
+	"client_insecure", "client_insecure_unique_user", "client_message_write_timeout", "client_ping_interval",
+	"client_presence_expire_interval", "client_presence_ping_interval",
+	"client_queue_max_size", "client_request_max_size", "client_stale_close_delay",
+	"config_dir", "debug", "engine", "engine_routes", "graphite", "graphite_host", "graphite_interval",
+	"graphite_port", "graphite_prefix", "graphite_tags", "grpc_api",
+	"grpc_api_port", "health", "history_lifetime", "history_recover",
+	"history_size", "internal_address", "internal_port", "join_leave", "log_file",
+	"log_level", "name", "namespaces", "node_info_metrics_aggregate_interval",
+	"pid_file", "port", "presence", "prometheus", "publish", "redis_connect_timeout",
+	"redis_db", "redis_host", "redis_idle_timeout", "redis_master_name",
+	"redis_password", "redis_port", "redis_prefix", "redis_pubsub_num_workers",
+	"redis_read_timeout", "redis_sentinels", "redis_tls", "redis_tls_skip_verify",
+	"redis_url", "redis_write_timeout", "secret", "shutdown_termination_delay",
+	"shutdown_timeout", "sockjs_heartbeat_delay", "sockjs_url", "subscribe_to_publish",
+	"tls", "tls_autocert", "tls_autocert_cache_dir", "tls_autocert_email",
+	"tls_autocert_force_rsa", "tls_autocert_host_whitelist", "tls_autocert_http",
+	"tls_autocert_http_addr", "tls_autocert_server_name", "tls_cert", "tls_external",
+	"tls_key", "websocket_compression", "websocket_compression_level",
+	"websocket_compression_min_size", "websocket_read_buffer_size",
+	"websocket_write_buffer_size", "history_disable_for_client",
+	"presence_disable_for_client", "admin_handler_prefix", "websocket_handler_prefix",
+	"sockjs_handler_prefix", "api_handler_prefix", "prometheus_handler_prefix",
+	"health_handler_prefix", "grpc_api_tls", "grpc_api_tls_disable",
+	"grpc_api_tls_cert", "grpc_api_tls_key", "proxy_connect_endpoint",
+	"proxy_connect_timeout", "proxy_rpc_endpoint", "proxy_rpc_timeout",
+	"proxy_refresh_endpoint", "proxy_refresh_timeout",
+	"token_jwks_public_endpoint", "token_rsa_public_key", "token_ecdsa_public_key", "token_hmac_secret_key",
+	"token_hmac_secondary_secret_keys", "connect_token_hmac_secondary_secret_keys",
+	"subscribe_token_hmac_secondary_secret_keys",
+	"connect_token_hmac_secret_key", "subscribe_token_hmac_secret_key",
+	"redis_sequence_ttl", "proxy_extra_http_headers", "server_side", "user_subscribe_to_personal",
+	"user_personal_channel_namespace", "websocket_use_write_buffer_pool",
+	"websocket_disable", "sockjs_disable", "api_disable", "redis_cluster_addrs",
+	"broker", "nats_prefix", "nats_url", "nats_dial_timeout", "nats_write_timeout",
+	"v3_use_offset", "redis_history_meta_ttl", "redis_streams", "memory_history_meta_ttl",
+	"websocket_ping_interval", "websocket_write_timeout", "websocket_message_size_limit",
+	"proxy_publish_endpoint", "proxy_publish_timeout", "proxy_subscribe_endpoint",
+	"proxy_subscribe_timeout", "proxy_subscribe", "proxy_publish", "expire_unsubscribe", "subscribe_state", "redis_sentinel_password",
+	"admin_users", "publish_data_validation", "channel_hierarchy_delimiter",
+	"proxy_refresh_sign_info", "proxy_refresh_interval", "broadcast_workers", "client_server_time", "client_presence_ping", "client_session_ttl", "node_channel_limit",
+	"forbid_secret_reuse", "admin_metrics_interval", "admin_metrics_window",
+	"overload_connection_capacity", "overload_reconnect_delay_min", "overload_reconnect_delay_max",
+	"client_addr", "api_addr", "admin_addr", "join_leave_batch_interval", "tls_min_version", "tls_cipher_suites",
+	"engine_publish_failure_policy", "engine_slow_log_threshold", "control_unknown_policy", "client_presence_refresh_on_activity",
+	"channel_root_disable", "api_response_envelope", "api_accept_form", "node_info_soft_limit",
+	"maintenance", "maintenance_message", "maintenance_block_subscribe",
+	"presence_max_size", "presence_eviction_policy", "presence_node_name",
+	"reuse_port",
+	"grpc_api_key", "client_concurrency", "user_personal_single_connection", "allowed_origins",
+}
+
+// setupEnv makes every option resolvable over CENTRIFUGO_<OPTION_NAME> env
+// variable, also ones not listed in bindEnvs. Flags bound later with BindPFlag
+// take precedence over env.
+func setupEnv(v *viper.Viper) {
+	v.SetEnvPrefix("centrifugo")
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	v.AutomaticEnv()
+	for _, env := range bindEnvs {
+		_ = v.BindEnv(env)
+	}
+}
+
+// setDefaults sets default values for all options.
+func setDefaults(v *viper.Viper) {
+	for key, value := range configDefaults {
+		v.SetDefault(key, value)
+	}
 }
 
 func main() {
 	var configFile string
+	var dumpConfig bool
 
-	viper.SetEnvPrefix("centrifugo")
+	setupEnv(viper.GetViper())
 
 	bindConfig := func() {
-		for k, v := range configDefaults {
-			viper.SetDefault(k, v)
-		}
-
-		bindEnvs := []string{
-			"address", "admin", "admin_external", "admin_insecure", "admin_password",
-			"admin_secret", "admin_web_path", "anonymous", "api_insecure", "api_key",
-			"channel_max_length", "channel_namespace_boundary", "channel_private_prefix",
-			"channel_user_boundary", "channel_user_separator", "client_anonymous",
-			"client_channel_limit", "client_channel_position_check_delay",
-			"client_expired_close_delay", "client_expired_sub_close_delay",
-			"client_insecure", "client_message_write_timeout", "client_ping_interval",
-			"client_presence_expire_interval", "client_presence_ping_interval",
-			"client_queue_max_size", "client_request_max_size", "client_stale_close_delay",
-			"debug", "engine", "graphite", "graphite_host", "graphite_interval",
-			"graphite_port", "graphite_prefix", "graphite_tags", "grpc_api",
-			"grpc_api_port", "health", "history_lifetime", "history_recover",
-			"history_size", "internal_address", "internal_port", "join_leave", "log_file",
-			"log_level", "name", "namespaces", "node_info_metrics_aggregate_interval",
-			"pid_file", "port", "presence", "prometheus", "publish", "redis_connect_timeout",
-			"redis_db", "redis_host", "redis_idle_timeout", "redis_master_name",
-			"redis_password", "redis_port", "redis_prefix", "redis_pubsub_num_workers",
-			"redis_read_timeout", "redis_sentinels", "redis_tls", "redis_tls_skip_verify",
-			"redis_url", "redis_write_timeout", "secret", "shutdown_termination_delay",
-			"shutdown_timeout", "sockjs_heartbeat_delay", "sockjs_url", "subscribe_to_publish",
-			"tls", "tls_autocert", "tls_autocert_cache_dir", "tls_autocert_email",
-			"tls_autocert_force_rsa", "tls_autocert_host_whitelist", "tls_autocert_http",
-			"tls_autocert_http_addr", "tls_autocert_server_name", "tls_cert", "tls_external",
-			"tls_key", "websocket_compression", "websocket_compression_level",
-			"websocket_compression_min_size", "websocket_read_buffer_size",
-			"websocket_write_buffer_size", "history_disable_for_client",
-			"presence_disable_for_client", "admin_handler_prefix", "websocket_handler_prefix",
-			"sockjs_handler_prefix", "api_handler_prefix", "prometheus_handler_prefix",
-			"health_handler_prefix", "grpc_api_tls", "grpc_api_tls_disable",
-			"grpc_api_tls_cert", "grpc_api_tls_key", "proxy_connect_endpoint",
-			"proxy_connect_timeout", "proxy_rpc_endpoint", "proxy_rpc_timeout",
-			"proxy_refresh_endpoint", "proxy_refresh_timeout",
-			"token_jwks_public_endpoint", "token_rsa_public_key", "token_ecdsa_public_key", "token_hmac_secret_key",
-			"redis_sequence_ttl", "proxy_extra_http_headers", "server_side", "user_subscribe_to_personal",
-			"user_personal_channel_namespace", "websocket_use_write_buffer_pool",
-			"websocket_disable", "sockjs_disable", "api_disable", "redis_cluster_addrs",
-			"broker", "nats_prefix", "nats_url", "nats_dial_timeout", "nats_write_timeout",
-			"v3_use_offset", "redis_history_meta_ttl", "redis_streams", "memory_history_meta_ttl",
-			"websocket_ping_interval", "websocket_write_timeout", "websocket_message_size_limit",
-			"proxy_publish_endpoint", "proxy_publish_timeout", "proxy_subscribe_endpoint",
-			"proxy_subscribe_timeout", "proxy_subscribe", "proxy_publish", "redis_sentinel_password",
-			"grpc_api_key", "client_concurrency", "user_personal_single_connection", "allowed_origins",
-		}
-
-		for _, env := range bindEnvs {
-			_ = viper.BindEnv(env)
-		}
+		setDefaults(viper.GetViper())
 	}
 
 	var rootCmd = &cobra.Command{
@@ -236,7 +329,7 @@ func main() {
 			bindConfig()
 
 			bindPFlags := []string{
-				"engine", "log_level", "log_file", "pid_file", "debug", "name", "admin",
+				"engine", "log_level", "log_file", "pid_file", "config_dir", "debug", "name", "admin",
 				"admin_external", "client_insecure", "admin_insecure", "api_insecure",
 				"port", "address", "tls", "tls_cert", "tls_key", "tls_external", "internal_port",
 				"internal_address", "prometheus", "health", "redis_host", "redis_port",
@@ -245,8 +338,21 @@ func main() {
 				"grpc_api_tls", "grpc_api_tls_disable", "grpc_api_tls_cert", "grpc_api_tls_key",
 				"grpc_api_port", "broker", "nats_url",
 			}
-			for _, flag := range bindPFlags {
-				_ = viper.BindPFlag(flag, cmd.Flags().Lookup(flag))
+			bindFlags := func(v *viper.Viper) {
+				for _, flag := range bindPFlags {
+					_ = v.BindPFlag(flag, cmd.Flags().Lookup(flag))
+				}
+			}
+			bindFlags(viper.GetViper())
+
+			// newViper returns viper instance configured the same way as global
+			// one before config file read, used to validate config on reload.
+			newViper := func() *viper.Viper {
+				v := viper.New()
+				setupEnv(v)
+				setDefaults(v)
+				bindFlags(v)
+				return v
 			}
 			viper.SetConfigFile(configFile)
 
@@ -266,6 +372,15 @@ func main() {
 				}
 			}
 
+			if dumpConfig {
+				data, err := configDump()
+				if err != nil {
+					log.Fatal().Msgf("error dumping configuration: %v", err)
+				}
+				fmt.Print(string(data))
+				return
+			}
+
 			file := setupLogging()
 			if file != nil {
 				defer func() { _ = file.Close() }()
@@ -302,14 +417,58 @@ func main() {
 
 			proxyConfig, _ := proxyConfig()
 
-			ruleConfig := ruleConfig()
+			ruleConfig, err := ruleConfig(viper.GetViper())
+			if err != nil {
+				log.Fatal().Msgf("error reading config: %v", err)
+			}
 			err = ruleConfig.Validate()
 			if err != nil {
 				log.Fatal().Msgf("error validating config: %v", err)
 			}
+			configinfo.Set(configinfo.Values(viper.Get))
 			ruleContainer := rule.NewContainer(ruleConfig)
 
-			nodeConfig := nodeConfig(VERSION)
+			jwtConfig, err := jwtVerifierConfig(viper.GetViper())
+			if err != nil {
+				log.Fatal().Msgf("error validating config: %v", err)
+			}
+			if err := jwtConfig.Validate(); err != nil {
+				log.Fatal().Msgf("error validating config: %v", err)
+			}
+
+			if err := checkSecretReuse(viper.GetViper()); err != nil {
+				log.Fatal().Msgf("error validating config: %v", err)
+			}
+
+			if err := checkPresenceExpire(viper.GetViper(), ruleConfig); err != nil {
+				log.Fatal().Msgf("error validating config: %v", err)
+			}
+
+			if err := apiHandlerConfig(viper.GetViper()).Validate(); err != nil {
+				log.Fatal().Msgf("error validating config: %v", err)
+			}
+
+			if err := apiExecutorConfig(viper.GetViper()).Validate(); err != nil {
+				log.Fatal().Msgf("error validating config: %v", err)
+			}
+
+			if err := fallbackConfig(viper.GetViper()).Validate(); err != nil {
+				log.Fatal().Msgf("error validating config: %v", err)
+			}
+
+			if err := controlConfig(viper.GetViper()).Validate(); err != nil {
+				log.Fatal().Msgf("error validating config: %v", err)
+			}
+
+			if viper.GetInt("node_channel_limit") < 0 {
+				log.Fatal().Msg("error validating config: node channel limit can not be negative")
+			}
+
+			nodeConfig := nodeConfig(viper.GetViper(), VERSION)
+			if err := tools.CheckNodeIntervals(nodeConfig); err != nil {
+				log.Fatal().Msgf("error validating config: %v", err)
+			}
+			nodeConfig.LogHandler = newLogHandler().handle
 
 			if !viper.GetBool("v3_use_offset") {
 				log.Warn().Msgf("consider migrating to offset protocol field, details: https://github.com/centrifugal/centrifugo/releases/tag/v2.5.0")
@@ -339,13 +498,61 @@ func main() {
 				log.Fatal().Msgf("error creating engine: %v", err)
 			}
 
-			tokenVerifier := jwtverify.NewTokenVerifierJWT(jwtVerifierConfig())
+			// Presence size limits tracked in process memory so only applied
+			// when all channels handled by Memory engine.
+			localPresence := engineName == "memory"
+			routes, err := engineRoutesFromConfig(viper.GetViper())
+			if err != nil {
+				log.Fatal().Msgf("error reading engine routes: %v", err)
+			}
+			if len(routes) > 0 {
+				if brokerName != "" {
+					log.Fatal().Msg("engine_routes can't be used together with broker")
+				}
+				engines := map[string]centrifuge.Engine{engineName: e}
+				var engineRoutes []enginerouter.Route
+				for _, route := range routes {
+					routeEngine, ok := engines[route.Engine]
+					if !ok {
+						if route.Engine == "memory" {
+							routeEngine, err = memoryEngine(node)
+						} else if route.Engine == "redis" {
+							routeEngine, err = redisEngine(node)
+						} else {
+							log.Fatal().Msgf("unknown engine in engine_routes: %s", route.Engine)
+						}
+						if err != nil {
+							log.Fatal().Msgf("error creating engine: %v", err)
+						}
+						engines[route.Engine] = routeEngine
+					}
+					if route.Engine != "memory" {
+						localPresence = false
+					}
+					engineRoutes = append(engineRoutes, enginerouter.Route{Prefix: route.Prefix, Engine: routeEngine})
+					log.Info().Str("prefix", route.Prefix).Str("engine", strings.Title(route.Engine)).Msg("channel prefix routed to engine")
+				}
+				e = enginerouter.New(e, engineRoutes)
+			}
+
+			tokenVerifier := jwtverify.NewTokenVerifierJWT(jwtConfig)
+
+			maintenanceMode := maintenance.New(maintenance.State{
+				Enabled:        viper.GetBool("maintenance"),
+				Message:        viper.GetString("maintenance_message"),
+				BlockSubscribe: viper.GetBool("maintenance_block_subscribe"),
+			})
 
 			clientHandler := client.NewHandler(node, ruleContainer, tokenVerifier, proxyConfig)
+			clientHandler.SetMaintenance(maintenanceMode)
+			clientHandler.SetNodeChannelLimit(viper.GetInt("node_channel_limit"))
 			clientHandler.Setup()
 
 			node.SetEngine(e)
 
+			engineStats := enginestats.New()
+			engineStats.SetSlowThreshold(time.Duration(viper.GetFloat64("engine_slow_log_threshold")*1000) * time.Millisecond)
+
 			var disableHistoryPresence bool
 			if engineName == "memory" && brokerName == "nats" {
 				// Presence and History won't work with Memory engine in distributed case.
@@ -357,12 +564,29 @@ func main() {
 				log.Warn().Msgf("presence, history and recovery disabled with Memory engine and Nats broker")
 			}
 
+			if !disableHistoryPresence {
+				var presenceManager centrifuge.PresenceManager = enginestats.NewPresenceManager(e, engineStats)
+				presenceManager = presence.NewNodeTracker(presenceManager, nodeConfig.Name, ruleContainer)
+				// Wraps NodeTracker so node name entries use namespace expiration too.
+				presenceManager = presence.NewExpireOverride(presenceManager, ruleContainer)
+				if localPresence {
+					presenceManager = presence.New(node, presenceManager, ruleContainer)
+				}
+				if viper.GetBool("client_presence_refresh_on_activity") {
+					refresher := presence.NewRefresher(presenceManager)
+					clientHandler.SetPresenceRefresher(refresher)
+					presenceManager = refresher
+				}
+				node.SetPresenceManager(presenceManager)
+			}
+
 			if !configFound {
 				log.Warn().Msg("config file not found")
 			}
 
+			var broker centrifuge.Broker = e
 			if brokerName == "nats" {
-				broker, err := natsbroker.New(node, natsbroker.Config{
+				natsBroker, err := natsbroker.New(node, natsbroker.Config{
 					URL:          viper.GetString("nats_url"),
 					Prefix:       viper.GetString("nats_prefix"),
 					DialTimeout:  time.Duration(viper.GetInt("nats_dial_timeout")) * time.Second,
@@ -371,13 +595,20 @@ func main() {
 				if err != nil {
 					log.Fatal().Msgf("Error creating broker: %v", err)
 				}
-				node.SetBroker(broker)
+				broker = natsBroker
 			}
+			broker = enginestats.NewBroker(broker, engineStats)
+			publicationTimes := pubtime.New(broker)
+			node.SetBroker(controlmsg.New(node, substate.New(joinleave.New(node, fallback.New(node, publicationTimes, fallbackConfig(viper.GetViper())), ruleContainer), ruleContainer), controlConfig(viper.GetViper())))
 
 			if err = node.Run(); err != nil {
 				log.Fatal().Msgf("error running node: %v", err)
 			}
 
+			go nodewatch.New(node, nodewatch.Config{
+				SoftLimit: viper.GetInt("node_info_soft_limit"),
+			}).Run()
+
 			if proxyConfig.ConnectEndpoint != "" {
 				log.Info().Str("endpoint", proxyConfig.ConnectEndpoint).Msg("proxy connect over HTTP")
 			}
@@ -411,9 +642,9 @@ func main() {
 			var grpcAPIAddr string
 			if viper.GetBool("grpc_api") {
 				grpcAPIAddr = fmt.Sprintf(":%d", viper.GetInt("grpc_api_port"))
-				grpcAPIConn, err := net.Listen("tcp", grpcAPIAddr)
+				grpcAPIConn, err := reuseport.Listen("tcp", grpcAPIAddr, viper.GetBool("reuse_port"))
 				if err != nil {
-					log.Fatal().Msgf("cannot listen to address %s", grpcAPIAddr)
+					log.Fatal().Msgf("cannot listen to address %s: %v", grpcAPIAddr, err)
 				}
 				var grpcOpts []grpc.ServerOption
 				var tlsConfig *tls.Config
@@ -435,6 +666,10 @@ func main() {
 				}
 				grpcAPIServer = grpc.NewServer(grpcOpts...)
 				apiExecutor := api.NewExecutor(node, ruleContainer, "grpc")
+				apiExecutor.SetConfig(apiExecutorConfig(viper.GetViper()))
+				apiExecutor.SetPublicationTimes(publicationTimes)
+				apiExecutor.SetEngineStats(engineStats)
+				apiExecutor.SetMaintenance(maintenanceMode)
 				_ = api.RegisterGRPCServerAPI(node, apiExecutor, grpcAPIServer, api.GRPCAPIServiceConfig{})
 				go func() {
 					if err := grpcAPIServer.Serve(grpcAPIConn); err != nil {
@@ -448,6 +683,10 @@ func main() {
 			}
 
 			httpAPIExecutor := api.NewExecutor(node, ruleContainer, "http")
+			httpAPIExecutor.SetConfig(apiExecutorConfig(viper.GetViper()))
+			httpAPIExecutor.SetPublicationTimes(publicationTimes)
+			httpAPIExecutor.SetEngineStats(engineStats)
+			httpAPIExecutor.SetMaintenance(maintenanceMode)
 			servers, err := runHTTPServers(node, httpAPIExecutor)
 			if err != nil {
 				log.Fatal().Msgf("error running HTTP server: %v", err)
@@ -464,16 +703,18 @@ func main() {
 				})
 			}
 
-			handleSignals(configFile, node, ruleContainer, tokenVerifier, servers, grpcAPIServer, exporter)
+			handleSignals(configFile, newViper, node, ruleContainer, tokenVerifier, servers, grpcAPIServer, exporter)
 		},
 	}
 
 	rootCmd.Flags().StringVarP(&configFile, "config", "c", "config.json", "path to config file")
+	rootCmd.Flags().BoolVarP(&dumpConfig, "dump_config", "", false, "print effective configuration with secrets redacted and exit")
 	rootCmd.Flags().StringP("engine", "e", "memory", "engine to use: memory or redis")
 	rootCmd.Flags().StringP("broker", "", "", "custom broker to use: ex. nats")
 	rootCmd.Flags().StringP("log_level", "", "info", "set the log level: debug, info, error, fatal or none")
 	rootCmd.Flags().StringP("log_file", "", "", "optional log file - if not specified logs go to STDOUT")
 	rootCmd.Flags().StringP("pid_file", "", "", "optional path to create PID file")
+	rootCmd.Flags().StringP("config_dir", "", "", "optional path to directory with namespaces config fragments")
 	rootCmd.Flags().StringP("name", "n", "", "unique node name")
 
 	rootCmd.Flags().BoolP("debug", "", false, "enable debug endpoints")
@@ -534,7 +775,8 @@ func main() {
 		Long:  `Check Centrifugo configuration file`,
 		Run: func(cmd *cobra.Command, args []string) {
 			bindConfig()
-			err := validateConfig(checkConfigFile)
+			_ = viper.BindPFlag("config_dir", cmd.Flags().Lookup("config_dir"))
+			err := validateConfig(viper.GetViper(), checkConfigFile)
 			if err != nil {
 				fmt.Printf("error: %v\n", err)
 				os.Exit(1)
@@ -542,20 +784,22 @@ func main() {
 		},
 	}
 	checkConfigCmd.Flags().StringVarP(&checkConfigFile, "config", "c", "config.json", "path to config file to check")
+	checkConfigCmd.Flags().StringP("config_dir", "", "", "path to directory with namespaces config fragments to check")
 
 	var outputConfigFile string
+	var genConfigNamespaces []string
 
 	var genConfigCmd = &cobra.Command{
 		Use:   "genconfig",
 		Short: "Generate minimal configuration file to start with",
 		Long:  `Generate minimal configuration file to start with`,
 		Run: func(cmd *cobra.Command, args []string) {
-			err := tools.GenerateConfig(outputConfigFile)
+			err := tools.GenerateConfig(outputConfigFile, genConfigNamespaces)
 			if err != nil {
 				fmt.Printf("error: %v\n", err)
 				os.Exit(1)
 			}
-			err = validateConfig(outputConfigFile)
+			err = validateConfig(viper.GetViper(), outputConfigFile)
 			if err != nil {
 				_ = os.Remove(outputConfigFile)
 				fmt.Printf("error: %v\n", err)
@@ -564,6 +808,27 @@ func main() {
 		},
 	}
 	genConfigCmd.Flags().StringVarP(&outputConfigFile, "config", "c", "config.json", "path to output config file")
+	genConfigCmd.Flags().StringArrayVarP(&genConfigNamespaces, "namespace", "n", nil, "name of channel namespace to add into config, can be repeated")
+
+	var upgradeConfigFile string
+
+	var upgradeConfigCmd = &cobra.Command{
+		Use:   "upgradeconfig",
+		Short: "Replace deprecated options in configuration file",
+		Long:  `Replace deprecated options in configuration file with their current names`,
+		Run: func(cmd *cobra.Command, args []string) {
+			bindConfig()
+			renamed, err := upgradeConfig(upgradeConfigFile)
+			if err != nil {
+				fmt.Printf("error: %v\n", err)
+				os.Exit(1)
+			}
+			for _, name := range renamed {
+				fmt.Printf("replaced deprecated option %s\n", name)
+			}
+		},
+	}
+	upgradeConfigCmd.Flags().StringVarP(&upgradeConfigFile, "config", "c", "config.json", "path to config file to upgrade")
 
 	var genTokenConfigFile string
 	var genTokenUser string
@@ -575,12 +840,16 @@ func main() {
 		Long:  `Generate sample connection JWT for user`,
 		Run: func(cmd *cobra.Command, args []string) {
 			bindConfig()
-			err := readConfig(genTokenConfigFile)
+			err := readConfig(viper.GetViper(), genTokenConfigFile)
 			if err != nil && err != errConfigFileNotFound {
 				fmt.Printf("error: %v\n", err)
 				os.Exit(1)
 			}
-			jwtVerifierConfig := jwtVerifierConfig()
+			jwtVerifierConfig, err := jwtVerifierConfig(viper.GetViper())
+			if err != nil {
+				fmt.Printf("error: %v\n", err)
+				os.Exit(1)
+			}
 			token, err := tools.GenerateToken(jwtVerifierConfig, genTokenUser, genTokenTTL)
 			if err != nil {
 				fmt.Printf("error: %v\n", err)
@@ -605,12 +874,16 @@ func main() {
 		Long:  `Check connection JWT`,
 		Run: func(cmd *cobra.Command, args []string) {
 			bindConfig()
-			err := readConfig(checkTokenConfigFile)
+			err := readConfig(viper.GetViper(), checkTokenConfigFile)
 			if err != nil && err != errConfigFileNotFound {
 				fmt.Printf("error: %v\n", err)
 				os.Exit(1)
 			}
-			jwtVerifierConfig := jwtVerifierConfig()
+			jwtVerifierConfig, err := jwtVerifierConfig(viper.GetViper())
+			if err != nil {
+				fmt.Printf("error: %v\n", err)
+				os.Exit(1)
+			}
 			if len(args) != 1 {
 				fmt.Printf("error: provide token to check [centrifugo checktoken <TOKEN>]\n")
 				os.Exit(1)
@@ -654,6 +927,7 @@ func main() {
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(checkConfigCmd)
 	rootCmd.AddCommand(genConfigCmd)
+	rootCmd.AddCommand(upgradeConfigCmd)
 	rootCmd.AddCommand(genTokenCmd)
 	rootCmd.AddCommand(checkTokenCmd)
 	_ = rootCmd.Execute()
@@ -710,7 +984,7 @@ func setupLogging() *os.File {
 	return nil
 }
 
-func handleSignals(configFile string, n *centrifuge.Node, ruleContainer *rule.Container, tokenVerifier *jwtverify.VerifierJWT, httpServers []*http.Server, grpcAPIServer *grpc.Server, exporter *graphite.Exporter) {
+func handleSignals(configFile string, newViper func() *viper.Viper, n *centrifuge.Node, ruleContainer *rule.Container, tokenVerifier *jwtverify.VerifierJWT, httpServers []*http.Server, grpcAPIServer *grpc.Server, exporter *graphite.Exporter) {
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGHUP, syscall.SIGINT, os.Interrupt, syscall.SIGTERM)
 	for {
@@ -720,13 +994,17 @@ func handleSignals(configFile string, n *centrifuge.Node, ruleContainer *rule.Co
 		case syscall.SIGHUP:
 			// reload application configuration on SIGHUP.
 			log.Info().Msg("reloading configuration")
-			err := validateConfig(configFile)
+			v, ruleConfig, err := reloadConfig(configFile, newViper)
 			if err != nil {
 				log.Error().Msgf("error parsing configuration: %s", err)
 				continue
 			}
-			ruleConfig := ruleConfig()
-			if err := tokenVerifier.Reload(jwtVerifierConfig()); err != nil {
+			jwtConfig, err := jwtVerifierConfig(v)
+			if err != nil {
+				log.Error().Msgf("error reloading: %v", err)
+				continue
+			}
+			if err := tokenVerifier.Reload(jwtConfig); err != nil {
 				log.Error().Msgf("error reloading: %v", err)
 				continue
 			}
@@ -734,6 +1012,7 @@ func handleSignals(configFile string, n *centrifuge.Node, ruleContainer *rule.Co
 				log.Error().Msgf("error reloading: %v", err)
 				continue
 			}
+			configinfo.Set(configinfo.Values(viper.Get))
 			log.Info().Msg("configuration successfully reloaded")
 		case syscall.SIGINT, os.Interrupt, syscall.SIGTERM:
 			log.Info().Msg("shutting down ...")
@@ -805,6 +1084,11 @@ func getTLSConfig() (*tls.Config, error) {
 	tlsAutocertHTTP := viper.GetBool("tls_autocert_http")
 	tlsAutocertHTTPAddr := viper.GetString("tls_autocert_http_addr")
 
+	// Check TLS restrictions even when TLS is off to fail on invalid config early.
+	if err := applyTLSRestrictions(&tls.Config{}); err != nil {
+		return nil, err
+	}
+
 	if tlsAutocertEnabled {
 		certManager := autocert.Manager{
 			Prompt: autocert.AcceptTOS,
@@ -836,7 +1120,7 @@ func getTLSConfig() (*tls.Config, error) {
 			})
 		}
 
-		return &tls.Config{
+		tlsConfig := &tls.Config{
 			GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
 				// See https://github.com/centrifugal/centrifugo/issues/144#issuecomment-279393819
 				if tlsAutocertServerName != "" && hello.ServerName == "" {
@@ -847,7 +1131,11 @@ func getTLSConfig() (*tls.Config, error) {
 			NextProtos: []string{
 				"h2", "http/1.1", acme.ALPNProto,
 			},
-		}, nil
+		}
+		if err := applyTLSRestrictions(tlsConfig); err != nil {
+			return nil, err
+		}
+		return tlsConfig, nil
 
 	} else if tlsEnabled {
 		// Autocert disabled - just try to use provided SSL cert and key files.
@@ -859,6 +1147,9 @@ func getTLSConfig() (*tls.Config, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err := applyTLSRestrictions(tlsConfig); err != nil {
+			return nil, err
+		}
 		return tlsConfig, nil
 	}
 
@@ -875,9 +1166,22 @@ func tlsConfigForGRPC() (*tls.Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := applyTLSRestrictions(tlsConfig); err != nil {
+		return nil, err
+	}
 	return tlsConfig, nil
 }
 
+// applyTLSRestrictions sets minimal TLS version and allowed cipher suites
+// from configuration.
+func applyTLSRestrictions(tlsConfig *tls.Config) error {
+	var cipherSuites []string
+	if suites := viper.GetString("tls_cipher_suites"); suites != "" {
+		cipherSuites = strings.Split(suites, ",")
+	}
+	return tlsconfig.Apply(tlsConfig, viper.GetString("tls_min_version"), cipherSuites)
+}
+
 type httpErrorLogWriter struct {
 	zerolog.Logger
 }
@@ -887,18 +1191,21 @@ func (w *httpErrorLogWriter) Write(data []byte) (int, error) {
 	return len(data), nil
 }
 
-func runHTTPServers(n *centrifuge.Node, apiExecutor *api.Executor) ([]*http.Server, error) {
-	debug := viper.GetBool("debug")
-	useAdmin := viper.GetBool("admin")
-	usePrometheus := viper.GetBool("prometheus")
-	useHealth := viper.GetBool("health")
+// httpHandlerAddrs groups HTTP handlers by address to serve them on.
+// Also returns shared external address and address of client endpoints as
+// TLS for external endpoints only applied to them.
+func httpHandlerAddrs(v *viper.Viper) (addrToHandlerFlags map[string]HandlerFlag, externalAddr string, clientAddr string) {
+	debug := v.GetBool("debug")
+	useAdmin := v.GetBool("admin")
+	usePrometheus := v.GetBool("prometheus")
+	useHealth := v.GetBool("health")
 
-	adminExternal := viper.GetBool("admin_external")
+	adminExternal := v.GetBool("admin_external")
 
-	httpAddress := viper.GetString("address")
-	httpPort := viper.GetString("port")
-	httpInternalAddress := viper.GetString("internal_address")
-	httpInternalPort := viper.GetString("internal_port")
+	httpAddress := v.GetString("address")
+	httpPort := v.GetString("port")
+	httpInternalAddress := v.GetString("internal_address")
+	httpInternalPort := v.GetString("internal_port")
 
 	if httpInternalAddress == "" && httpAddress != "" {
 		// If custom internal address not explicitly set we try to reuse main
@@ -912,44 +1219,59 @@ func runHTTPServers(n *centrifuge.Node, apiExecutor *api.Executor) ([]*http.Serv
 		httpInternalPort = httpPort
 	}
 
-	// addrToHandlerFlags contains mapping between HTTP server address and
-	// handler flags to serve on this address.
-	addrToHandlerFlags := map[string]HandlerFlag{}
-
-	var portFlags HandlerFlag
+	externalAddr = net.JoinHostPort(httpAddress, httpPort)
+	internalAddr := net.JoinHostPort(httpInternalAddress, httpInternalPort)
 
-	externalAddr := net.JoinHostPort(httpAddress, httpPort)
-	portFlags = addrToHandlerFlags[externalAddr]
-	if !viper.GetBool("websocket_disable") {
-		portFlags |= HandlerWebsocket
+	// Dedicated addresses for client, API and admin endpoints take precedence
+	// over shared external and internal addresses.
+	clientAddr = v.GetString("client_addr")
+	if clientAddr == "" {
+		clientAddr = externalAddr
 	}
-	if !viper.GetBool("sockjs_disable") {
-		portFlags |= HandlerSockJS
+	apiAddr := v.GetString("api_addr")
+	if apiAddr == "" {
+		apiAddr = internalAddr
 	}
-	if useAdmin && adminExternal {
-		portFlags |= HandlerAdmin
+	adminAddr := v.GetString("admin_addr")
+	if adminAddr == "" {
+		if adminExternal {
+			adminAddr = externalAddr
+		} else {
+			adminAddr = internalAddr
+		}
 	}
-	addrToHandlerFlags[externalAddr] = portFlags
 
-	internalAddr := net.JoinHostPort(httpInternalAddress, httpInternalPort)
-	portFlags = addrToHandlerFlags[internalAddr]
-	if !viper.GetBool("api_disable") {
-		portFlags |= HandlerAPI
-	}
+	// addrToHandlerFlags contains mapping between HTTP server address and
+	// handler flags to serve on this address.
+	addrToHandlerFlags = map[string]HandlerFlag{}
 
-	if useAdmin && !adminExternal {
-		portFlags |= HandlerAdmin
+	if !v.GetBool("websocket_disable") {
+		addrToHandlerFlags[clientAddr] |= HandlerWebsocket
+	}
+	if !v.GetBool("sockjs_disable") {
+		addrToHandlerFlags[clientAddr] |= HandlerSockJS
+	}
+	if !v.GetBool("api_disable") {
+		addrToHandlerFlags[apiAddr] |= HandlerAPI
+	}
+	if useAdmin {
+		addrToHandlerFlags[adminAddr] |= HandlerAdmin
 	}
 	if usePrometheus {
-		portFlags |= HandlerPrometheus
+		addrToHandlerFlags[internalAddr] |= HandlerPrometheus
 	}
 	if debug {
-		portFlags |= HandlerDebug
+		addrToHandlerFlags[internalAddr] |= HandlerDebug
 	}
 	if useHealth {
-		portFlags |= HandlerHealth
+		addrToHandlerFlags[internalAddr] |= HandlerHealth
 	}
-	addrToHandlerFlags[internalAddr] = portFlags
+
+	return addrToHandlerFlags, externalAddr, clientAddr
+}
+
+func runHTTPServers(n *centrifuge.Node, apiExecutor *api.Executor) ([]*http.Server, error) {
+	addrToHandlerFlags, externalAddr, clientAddr := httpHandlerAddrs(viper.GetViper())
 
 	var servers []*http.Server
 
@@ -969,7 +1291,7 @@ func runHTTPServers(n *centrifuge.Node, apiExecutor *api.Executor) ([]*http.Serv
 		log.Info().Msgf("serving %s endpoints on %s", handlerFlags, addr)
 
 		var addrTLSConfig *tls.Config
-		if !viper.GetBool("tls_external") || addr == externalAddr {
+		if !viper.GetBool("tls_external") || addr == externalAddr || addr == clientAddr {
 			addrTLSConfig = tlsConfig
 		}
 		server := &http.Server{
@@ -979,17 +1301,22 @@ func runHTTPServers(n *centrifuge.Node, apiExecutor *api.Executor) ([]*http.Serv
 			ErrorLog:  stdlog.New(&httpErrorLogWriter{log.Logger}, "", 0),
 		}
 
+		ln, err := reuseport.Listen("tcp", addr, viper.GetBool("reuse_port"))
+		if err != nil {
+			return nil, fmt.Errorf("cannot listen to address %s: %w", addr, err)
+		}
+
 		servers = append(servers, server)
 
 		go func() {
 			if addrTLSConfig != nil {
-				if err := server.ListenAndServeTLS("", ""); err != nil {
+				if err := server.ServeTLS(ln, "", ""); err != nil {
 					if err != http.ErrServerClosed {
 						log.Fatal().Msgf("ListenAndServe: %v", err)
 					}
 				}
 			} else {
-				if err := server.ListenAndServe(); err != nil {
+				if err := server.Serve(ln); err != nil {
 					if err != http.ErrServerClosed {
 						log.Fatal().Msgf("ListenAndServe: %v", err)
 					}
@@ -1004,9 +1331,9 @@ func runHTTPServers(n *centrifuge.Node, apiExecutor *api.Executor) ([]*http.Serv
 var errConfigFileNotFound = errors.New("unable to find configuration file")
 
 // readConfig reads config.
-func readConfig(f string) error {
-	viper.SetConfigFile(f)
-	err := viper.ReadInConfig()
+func readConfig(v *viper.Viper, f string) error {
+	v.SetConfigFile(f)
+	err := v.ReadInConfig()
 	if err != nil {
 		switch err.(type) {
 		case viper.ConfigParseError:
@@ -1018,21 +1345,180 @@ func readConfig(f string) error {
 	return nil
 }
 
+// upgradeConfig replaces deprecated options in config file. Upgraded
+// configuration validated before it replaces original file.
+func upgradeConfig(f string) ([]string, error) {
+	info, err := os.Stat(f)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(f)
+	if err != nil {
+		return nil, err
+	}
+	ext := strings.TrimPrefix(filepath.Ext(f), ".")
+	upgraded, renamed, err := tools.UpgradeConfig(data, ext)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(f), ".upgradeconfig_*."+ext)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	_, err = tmp.Write(upgraded)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := validateConfig(viper.GetViper(), tmp.Name()); err != nil {
+		return nil, fmt.Errorf("upgraded configuration is invalid: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), info.Mode()); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), f); err != nil {
+		return nil, err
+	}
+	return renamed, nil
+}
+
 // validateConfig validates config file located at provided path.
-func validateConfig(f string) error {
-	err := readConfig(f)
+func validateConfig(v *viper.Viper, f string) error {
+	err := readConfig(v, f)
 	if err != nil {
 		return err
 	}
-	ruleConfig := ruleConfig()
+	_, err = validateSettings(v)
+	return err
+}
+
+// validateSettings validates configuration already loaded into viper instance.
+// It returns validated rule.Config so the caller does not need to build it
+// again – config_dir fragments may change between reads.
+func validateSettings(v *viper.Viper) (rule.Config, error) {
+	ruleConfig, err := ruleConfig(v)
+	if err != nil {
+		return rule.Config{}, err
+	}
 	if err := ruleConfig.Validate(); err != nil {
+		return rule.Config{}, err
+	}
+	jwtConfig, err := jwtVerifierConfig(v)
+	if err != nil {
+		return rule.Config{}, err
+	}
+	if err := jwtConfig.Validate(); err != nil {
+		return rule.Config{}, err
+	}
+	if err := checkSecretReuse(v); err != nil {
+		return rule.Config{}, err
+	}
+	if err := checkPresenceExpire(v, ruleConfig); err != nil {
+		return rule.Config{}, err
+	}
+	if err := tools.CheckNodeIntervals(nodeConfig(v, VERSION)); err != nil {
+		return rule.Config{}, err
+	}
+	if err := apiHandlerConfig(v).Validate(); err != nil {
+		return rule.Config{}, err
+	}
+	if err := apiExecutorConfig(v).Validate(); err != nil {
+		return rule.Config{}, err
+	}
+	if err := fallbackConfig(v).Validate(); err != nil {
+		return rule.Config{}, err
+	}
+	if err := controlConfig(v).Validate(); err != nil {
+		return rule.Config{}, err
+	}
+	if v.GetInt("node_channel_limit") < 0 {
+		return rule.Config{}, errors.New("node channel limit can not be negative")
+	}
+	return ruleConfig, nil
+}
+
+// reloadConfig validates config file located at provided path in a fresh
+// viper instance and loads it into global viper only when valid, so rejected
+// configuration never becomes visible to running node. The same file contents
+// are used for both so the file changing between reads does not matter.
+func reloadConfig(f string, newViper func() *viper.Viper) (*viper.Viper, rule.Config, error) {
+	data, err := ioutil.ReadFile(f)
+	if err != nil {
+		return nil, rule.Config{}, err
+	}
+	v := newViper()
+	v.SetConfigFile(f)
+	if err := v.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, rule.Config{}, err
+	}
+	ruleConfig, err := validateSettings(v)
+	if err != nil {
+		return nil, rule.Config{}, err
+	}
+	global := viper.GetViper()
+	global.SetConfigFile(f)
+	if err := global.ReadConfig(bytes.NewReader(data)); err != nil {
+		return nil, rule.Config{}, err
+	}
+	return v, ruleConfig, nil
+}
+
+// checkPresenceExpire checks that namespace presence expiration overrides
+// are longer than presence ping interval – otherwise presence entries expire
+// between updates.
+func checkPresenceExpire(v *viper.Viper, c rule.Config) error {
+	pingInterval := v.GetInt("client_presence_ping_interval")
+	for _, n := range c.Namespaces {
+		if n.PresenceExpireInterval > 0 && n.PresenceExpireInterval <= pingInterval {
+			return fmt.Errorf("namespace %s: presence expire interval must be greater than client_presence_ping_interval (%d)", n.Name, pingInterval)
+		}
+	}
+	return nil
+}
+
+// checkSecretReuse warns when the same secret value used for several options.
+// Reusing secret allows credentials for one purpose to be valid for another one.
+// When forbid_secret_reuse option enabled reuse considered a configuration error.
+func checkSecretReuse(v *viper.Viper) error {
+	secrets := map[string]string{
+		"token_hmac_secret_key":           tokenHMACSecretKey(v),
+		"connect_token_hmac_secret_key":   v.GetString("connect_token_hmac_secret_key"),
+		"subscribe_token_hmac_secret_key": v.GetString("subscribe_token_hmac_secret_key"),
+		"admin_password":                  v.GetString("admin_password"),
+		"admin_secret":                    v.GetString("admin_secret"),
+		"api_key":                         v.GetString("api_key"),
+	}
+	for _, option := range []string{"token_hmac_secondary_secret_keys", "connect_token_hmac_secondary_secret_keys", "subscribe_token_hmac_secondary_secret_keys"} {
+		for i, key := range v.GetStringSlice(option) {
+			secrets[option+"."+strconv.Itoa(i)] = key
+		}
+	}
+	users, err := adminUsersFromConfig(v)
+	if err != nil {
 		return err
 	}
+	for _, u := range users {
+		secrets["admin_users."+u.Username+".password"] = u.Password
+	}
+	groups := tools.DuplicateSecrets(secrets)
+	if len(groups) == 0 {
+		return nil
+	}
+	if v.GetBool("forbid_secret_reuse") {
+		return fmt.Errorf("same secret value used for options: %s", strings.Join(groups[0], ", "))
+	}
+	for _, names := range groups {
+		log.Warn().Strs("options", names).Msg("same secret value used for several options, consider using unique secrets")
+	}
 	return nil
 }
 
-func ruleConfig() rule.Config {
-	v := viper.GetViper()
+// ruleConfig builds rule.Config from viper instance. It returns error when
+// config_dir fragments can not be merged.
+func ruleConfig(v *viper.Viper) (rule.Config, error) {
 	cfg := rule.Config{}
 
 	cfg.Publish = v.GetBool("publish")
@@ -1040,7 +1526,11 @@ func ruleConfig() rule.Config {
 	cfg.Anonymous = v.GetBool("anonymous")
 	cfg.Presence = v.GetBool("presence")
 	cfg.PresenceDisableForClient = v.GetBool("presence_disable_for_client")
+	cfg.PresenceMaxSize = v.GetInt("presence_max_size")
+	cfg.PresenceEvictionPolicy = rule.PresenceEvictionPolicy(v.GetString("presence_eviction_policy"))
+	cfg.PresenceNodeName = v.GetBool("presence_node_name")
 	cfg.JoinLeave = v.GetBool("join_leave")
+	cfg.JoinLeaveBatchInterval = v.GetInt("join_leave_batch_interval")
 	cfg.HistorySize = v.GetInt("history_size")
 	cfg.HistoryLifetime = v.GetInt("history_lifetime")
 	cfg.HistoryRecover = v.GetBool("history_recover")
@@ -1048,57 +1538,90 @@ func ruleConfig() rule.Config {
 	cfg.ServerSide = v.GetBool("server_side")
 	cfg.ProxySubscribe = v.GetBool("proxy_subscribe")
 	cfg.ProxyPublish = v.GetBool("proxy_publish")
-	cfg.Namespaces = namespacesFromConfig(v)
+	cfg.ExpireUnsubscribe = v.GetBool("expire_unsubscribe")
+	cfg.SubscribeState = v.GetBool("subscribe_state")
+	namespaces, err := configNamespaces(v)
+	if err != nil {
+		return rule.Config{}, err
+	}
+	cfg.Namespaces = namespaces
 
 	// TODO v3: replace option name to token_channel_prefix.
 	cfg.TokenChannelPrefix = v.GetString("channel_private_prefix")
 	cfg.ChannelNamespaceBoundary = v.GetString("channel_namespace_boundary")
 	cfg.ChannelUserBoundary = v.GetString("channel_user_boundary")
 	cfg.ChannelUserSeparator = v.GetString("channel_user_separator")
+	cfg.ChannelHierarchyDelimiter = v.GetString("channel_hierarchy_delimiter")
+	cfg.ChannelRootDisable = v.GetBool("channel_root_disable")
 	cfg.UserSubscribeToPersonal = v.GetBool("user_subscribe_to_personal")
 	cfg.UserPersonalSingleConnection = v.GetBool("user_personal_single_connection")
 	cfg.UserPersonalChannelNamespace = v.GetString("user_personal_channel_namespace")
 	cfg.ClientInsecure = v.GetBool("client_insecure")
+	cfg.ClientInsecureUniqueUser = v.GetBool("client_insecure_unique_user")
 	cfg.ClientAnonymous = v.GetBool("client_anonymous")
 	cfg.ClientConcurrency = v.GetInt("client_concurrency")
-	return cfg
+	cfg.ClientServerTime = v.GetBool("client_server_time")
+	cfg.ClientPresencePing = v.GetBool("client_presence_ping")
+	cfg.ClientSessionTTL = time.Duration(v.GetInt("client_session_ttl")) * time.Second
+	return cfg, nil
 }
 
-func jwtVerifierConfig() jwtverify.VerifierConfig {
-	v := viper.GetViper()
-	cfg := jwtverify.VerifierConfig{}
-
-	hmacSecretKey := v.GetString("token_hmac_secret_key")
-	if hmacSecretKey != "" {
-		cfg.HMACSecretKey = hmacSecretKey
-	} else {
-		if v.GetString("secret") != "" {
-			log.Warn().Msg("secret is deprecated, use token_hmac_secret_key instead")
+// tokenPublicKeys parses token public keys set in configuration, key is nil
+// when not set.
+func tokenPublicKeys(v *viper.Viper) (*rsa.PublicKey, *ecdsa.PublicKey, error) {
+	var (
+		rsaPublicKey   *rsa.PublicKey
+		ecdsaPublicKey *ecdsa.PublicKey
+		err            error
+	)
+	if key := v.GetString("token_rsa_public_key"); key != "" {
+		rsaPublicKey, err = jwtutils.ParseRSAPublicKeyFromPEM([]byte(key))
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing RSA public key: %w", err)
 		}
-		cfg.HMACSecretKey = v.GetString("secret")
 	}
-
-	rsaPublicKey := v.GetString("token_rsa_public_key")
-	if rsaPublicKey != "" {
-		pubKey, err := jwtutils.ParseRSAPublicKeyFromPEM([]byte(rsaPublicKey))
+	if key := v.GetString("token_ecdsa_public_key"); key != "" {
+		ecdsaPublicKey, err = jwtutils.ParseECDSAPublicKeyFromPEM([]byte(key))
 		if err != nil {
-			log.Fatal().Msgf("error parsing RSA public key: %v", err)
+			return nil, nil, fmt.Errorf("error parsing ECDSA public key: %w", err)
 		}
-		cfg.RSAPublicKey = pubKey
 	}
+	return rsaPublicKey, ecdsaPublicKey, nil
+}
 
-	ecdsaPublicKey := v.GetString("token_ecdsa_public_key")
-	if ecdsaPublicKey != "" {
-		pubKey, err := jwtutils.ParseECDSAPublicKeyFromPEM([]byte(ecdsaPublicKey))
-		if err != nil {
-			log.Fatal().Msgf("error parsing ECDSA public key: %v", err)
-		}
-		cfg.ECDSAPublicKey = pubKey
+// tokenHMACSecretKey returns token_hmac_secret_key falling back to
+// deprecated secret option.
+func tokenHMACSecretKey(v *viper.Viper) string {
+	hmacSecretKey := v.GetString("token_hmac_secret_key")
+	if hmacSecretKey != "" {
+		return hmacSecretKey
 	}
+	if v.GetString("secret") != "" {
+		log.Warn().Msg("secret is deprecated, use token_hmac_secret_key instead")
+	}
+	return v.GetString("secret")
+}
+
+func jwtVerifierConfig(v *viper.Viper) (jwtverify.VerifierConfig, error) {
+	cfg := jwtverify.VerifierConfig{}
+
+	cfg.HMACSecretKey = tokenHMACSecretKey(v)
+	cfg.ConnectHMACSecretKey = v.GetString("connect_token_hmac_secret_key")
+	cfg.SubscribeHMACSecretKey = v.GetString("subscribe_token_hmac_secret_key")
+	cfg.SecondaryHMACSecretKeys = v.GetStringSlice("token_hmac_secondary_secret_keys")
+	cfg.ConnectSecondaryHMACSecretKeys = v.GetStringSlice("connect_token_hmac_secondary_secret_keys")
+	cfg.SubscribeSecondaryHMACSecretKeys = v.GetStringSlice("subscribe_token_hmac_secondary_secret_keys")
+
+	rsaPublicKey, ecdsaPublicKey, err := tokenPublicKeys(v)
+	if err != nil {
+		return jwtverify.VerifierConfig{}, err
+	}
+	cfg.RSAPublicKey = rsaPublicKey
+	cfg.ECDSAPublicKey = ecdsaPublicKey
 
 	cfg.JWKSPublicEndpoint = v.GetString("token_jwks_public_endpoint")
 
-	return cfg
+	return cfg, nil
 }
 
 func proxyConfig() (proxy.Config, bool) {
@@ -1109,6 +1632,13 @@ func proxyConfig() (proxy.Config, bool) {
 	cfg.ConnectTimeout = time.Duration(v.GetFloat64("proxy_connect_timeout")*1000) * time.Millisecond
 	cfg.RefreshEndpoint = v.GetString("proxy_refresh_endpoint")
 	cfg.RefreshTimeout = time.Duration(v.GetFloat64("proxy_refresh_timeout")*1000) * time.Millisecond
+	if v.GetBool("proxy_refresh_sign_info") {
+		cfg.RefreshInfoHMACSecretKey = tokenHMACSecretKey(v)
+		if cfg.RefreshInfoHMACSecretKey == "" {
+			log.Fatal().Msg("token_hmac_secret_key required to verify refresh info signature")
+		}
+	}
+	cfg.RefreshInterval = time.Duration(v.GetInt("proxy_refresh_interval")) * time.Second
 	cfg.RPCEndpoint = v.GetString("proxy_rpc_endpoint")
 	cfg.RPCTimeout = time.Duration(v.GetFloat64("proxy_rpc_timeout")*1000) * time.Millisecond
 	cfg.SubscribeEndpoint = v.GetString("proxy_subscribe_endpoint")
@@ -1122,12 +1652,11 @@ func proxyConfig() (proxy.Config, bool) {
 	return cfg, proxyEnabled
 }
 
-func nodeConfig(version string) centrifuge.Config {
-	v := viper.GetViper()
+func nodeConfig(v *viper.Viper, version string) centrifuge.Config {
 	cfg := centrifuge.Config{}
 	cfg.Version = version
 	cfg.MetricsNamespace = "centrifugo"
-	cfg.Name = applicationName()
+	cfg.Name = applicationName(v)
 	cfg.ChannelMaxLength = v.GetInt("channel_max_length")
 	cfg.ClientPresenceUpdateInterval = time.Duration(v.GetInt("client_presence_ping_interval")) * time.Second
 	cfg.ClientPresenceExpireInterval = time.Duration(v.GetInt("client_presence_expire_interval")) * time.Second
@@ -1145,7 +1674,6 @@ func nodeConfig(version string) centrifuge.Config {
 		level = centrifuge.LogLevelInfo
 	}
 	cfg.LogLevel = level
-	cfg.LogHandler = newLogHandler().handle
 	return cfg
 }
 
@@ -1159,9 +1687,7 @@ var logStringToLevel = map[string]centrifuge.LogLevel{
 
 // applicationName returns a name for this centrifuge. If no name provided
 // in configuration then it constructs node name based on hostname and port
-func applicationName() string {
-	v := viper.GetViper()
-
+func applicationName(v *viper.Viper) string {
 	name := v.GetString("name")
 	if name != "" {
 		return name
@@ -1175,11 +1701,43 @@ func applicationName() string {
 	return hostname + "_" + port
 }
 
+// engineRoute maps channel prefix to engine name.
+type engineRoute struct {
+	Prefix string `mapstructure:"prefix" json:"prefix"`
+	Engine string `mapstructure:"engine" json:"engine"`
+}
+
+// engineRoutesFromConfig allows to unmarshal engine routes.
+func engineRoutesFromConfig(v *viper.Viper) ([]engineRoute, error) {
+	var routes []engineRoute
+	if !v.IsSet("engine_routes") {
+		return routes, nil
+	}
+	var err error
+	switch val := v.Get("engine_routes").(type) {
+	case string:
+		err = json.Unmarshal([]byte(val), &routes)
+	case []interface{}:
+		err = v.UnmarshalKey("engine_routes", &routes)
+	default:
+		err = fmt.Errorf("unknown engine_routes type: %T", val)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("malformed engine_routes: %w", err)
+	}
+	for _, route := range routes {
+		if route.Prefix == "" {
+			return nil, errors.New("engine route must have prefix")
+		}
+	}
+	return routes, nil
+}
+
 // namespacesFromConfig allows to unmarshal channel namespaces.
-func namespacesFromConfig(v *viper.Viper) []rule.ChannelNamespace {
+func namespacesFromConfig(v *viper.Viper) ([]rule.ChannelNamespace, error) {
 	var ns []rule.ChannelNamespace
 	if !v.IsSet("namespaces") {
-		return ns
+		return ns, nil
 	}
 	var err error
 	switch val := v.Get("namespaces").(type) {
@@ -1191,10 +1749,62 @@ func namespacesFromConfig(v *viper.Viper) []rule.ChannelNamespace {
 		err = fmt.Errorf("unknown namespaces type: %T", val)
 	}
 	if err != nil {
-		log.Error().Err(err).Msg("malformed namespaces")
-		os.Exit(1)
+		return nil, fmt.Errorf("malformed namespaces: %w", err)
+	}
+	return ns, nil
+}
+
+// configNamespaces returns namespaces of configuration file merged with
+// namespaces from config_dir fragments when set.
+func configNamespaces(v *viper.Viper) ([]rule.ChannelNamespace, error) {
+	namespaces, err := namespacesFromConfig(v)
+	if err != nil {
+		return nil, err
+	}
+	dir := v.GetString("config_dir")
+	if dir == "" {
+		return namespaces, nil
+	}
+	return configdir.Merge(v.ConfigFileUsed(), namespaces, dir)
+}
+
+func apiHandlerConfig(v *viper.Viper) api.Config {
+	return api.Config{
+		ResponseEnvelope: api.ResponseEnvelope(v.GetString("api_response_envelope")),
+		AcceptForm:       v.GetBool("api_accept_form"),
 	}
-	return ns
+}
+
+func apiExecutorConfig(v *viper.Viper) api.ExecutorConfig {
+	return api.ExecutorConfig{
+		BroadcastWorkers:      v.GetInt("broadcast_workers"),
+		PublishDataValidation: api.DataValidation(v.GetString("publish_data_validation")),
+
+		OverloadConnectionCapacity: v.GetInt("overload_connection_capacity"),
+		OverloadReconnectDelayMin:  time.Duration(v.GetInt("overload_reconnect_delay_min")) * time.Millisecond,
+		OverloadReconnectDelayMax:  time.Duration(v.GetInt("overload_reconnect_delay_max")) * time.Millisecond,
+	}
+}
+
+func fallbackConfig(v *viper.Viper) fallback.Config {
+	return fallback.Config{
+		PublishFailurePolicy: fallback.PublishFailurePolicy(v.GetString("engine_publish_failure_policy")),
+	}
+}
+
+func controlConfig(v *viper.Viper) controlmsg.Config {
+	return controlmsg.Config{
+		UnknownPolicy: controlmsg.UnknownPolicy(v.GetString("control_unknown_policy")),
+	}
+}
+
+// websocketPingInterval returns websocket_ping_interval falling back to
+// client_ping_interval.
+func websocketPingInterval(v *viper.Viper) time.Duration {
+	if v.IsSet("websocket_ping_interval") {
+		return time.Duration(v.GetInt("websocket_ping_interval")) * time.Second
+	}
+	return time.Duration(v.GetInt("client_ping_interval")) * time.Second
 }
 
 func websocketHandlerConfig() centrifuge.WebsocketConfig {
@@ -1206,11 +1816,7 @@ func websocketHandlerConfig() centrifuge.WebsocketConfig {
 	cfg.ReadBufferSize = v.GetInt("websocket_read_buffer_size")
 	cfg.WriteBufferSize = v.GetInt("websocket_write_buffer_size")
 	cfg.UseWriteBufferPool = v.GetBool("websocket_use_write_buffer_pool")
-	if v.IsSet("websocket_ping_interval") {
-		cfg.PingInterval = time.Duration(v.GetInt("websocket_ping_interval")) * time.Second
-	} else {
-		cfg.PingInterval = time.Duration(v.GetInt("client_ping_interval")) * time.Second
-	}
+	cfg.PingInterval = websocketPingInterval(v)
 	if v.IsSet("websocket_write_timeout") {
 		cfg.WriteTimeout = time.Duration(v.GetInt("websocket_write_timeout")) * time.Second
 	} else {
@@ -1297,9 +1903,107 @@ func adminHandlerConfig() admin.Config {
 	cfg.Secret = v.GetString("admin_secret")
 	cfg.Insecure = v.GetBool("admin_insecure")
 	cfg.Prefix = v.GetString("admin_handler_prefix")
+	users, err := adminUsersFromConfig(v)
+	if err != nil {
+		log.Fatal().Msgf("error reading admin users: %v", err)
+	}
+	cfg.Users = users
+	cfg.MetricsInterval = time.Duration(v.GetInt("admin_metrics_interval")) * time.Second
+	cfg.MetricsWindow = v.GetInt("admin_metrics_window")
+	cfg.ConfigDump = configDump
 	return cfg
 }
 
+// configDump returns JSON with effective configuration options and values
+// derived from them, secrets redacted.
+func configDump() ([]byte, error) {
+	v := viper.GetViper()
+	options := v.AllSettings()
+	namespaces, err := configNamespaces(v)
+	if err != nil {
+		return nil, err
+	}
+	options["namespaces"] = namespaces
+	// Admin users passed as plain maps so passwords redacted.
+	users, err := adminUsersFromConfig(v)
+	if err != nil {
+		return nil, err
+	}
+	var adminUsers []interface{}
+	for _, u := range users {
+		adminUsers = append(adminUsers, map[string]interface{}{
+			"username":      u.Username,
+			"password":      u.Password,
+			"password_file": u.PasswordFile,
+			"role":          u.Role,
+		})
+	}
+	options["admin_users"] = adminUsers
+	routes, err := engineRoutesFromConfig(v)
+	if err != nil {
+		return nil, err
+	}
+	if routes != nil {
+		options["engine_routes"] = routes
+	}
+	derived := map[string]interface{}{
+		"name":                    applicationName(v),
+		"token_hmac_secret_key":   tokenHMACSecretKey(v),
+		"websocket_ping_interval": websocketPingInterval(v).Seconds(),
+	}
+	return configdump.New(options, derived).JSON()
+}
+
+// adminUsersFromConfig allows to unmarshal admin users from configuration.
+// Admin users can be set as JSON array in env variable or as array in
+// configuration file.
+func adminUsersFromConfig(v *viper.Viper) ([]admin.User, error) {
+	var users []admin.User
+	if !v.IsSet("admin_users") {
+		return users, nil
+	}
+	var err error
+	switch val := v.Get("admin_users").(type) {
+	case string:
+		err = json.Unmarshal([]byte(val), &users)
+	case []interface{}:
+		err = v.UnmarshalKey("admin_users", &users)
+	default:
+		err = fmt.Errorf("unknown admin_users type: %T", val)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("malformed admin_users: %w", err)
+	}
+	seen := map[string]struct{}{}
+	for i, u := range users {
+		if u.Username == "" {
+			return nil, errors.New("admin user must have username")
+		}
+		if _, ok := seen[u.Username]; ok {
+			return nil, fmt.Errorf("duplicate admin user: %s", u.Username)
+		}
+		seen[u.Username] = struct{}{}
+		if u.Password == "" && u.PasswordFile != "" {
+			data, err := ioutil.ReadFile(u.PasswordFile)
+			if err != nil {
+				return nil, fmt.Errorf("error reading password file of admin user %s: %w", u.Username, err)
+			}
+			users[i].Password = strings.TrimSpace(string(data))
+		}
+		if users[i].Password == "" {
+			return nil, fmt.Errorf("admin user %s must have password", u.Username)
+		}
+		switch u.Role {
+		case "":
+			users[i].Role = admin.RoleReadOnly
+		case admin.RoleFull, admin.RoleReadOnly:
+		default:
+			return nil, fmt.Errorf("unknown role of admin user %s: %s", u.Username, u.Role)
+		}
+	}
+	return users, nil
+}
+
 func memoryEngine(n *centrifuge.Node) (centrifuge.Engine, error) {
 	c, err := memoryEngineConfig()
 	if err != nil {
@@ -1664,7 +2368,7 @@ func Mux(n *centrifuge.Node, apiExecutor *api.Executor, flags HandlerFlag) *http
 
 	if flags&HandlerAPI != 0 {
 		// register HTTP API endpoint.
-		apiHandler := api.NewHandler(n, apiExecutor, api.Config{})
+		apiHandler := api.NewHandler(n, apiExecutor, apiHandlerConfig(viper.GetViper()))
 		apiPrefix := strings.TrimRight(v.GetString("api_handler_prefix"), "/")
 		if apiPrefix == "" {
 			apiPrefix = "/"
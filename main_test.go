@@ -0,0 +1,376 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/centrifugal/centrifugo/internal/admin"
+
+	"github.com/FZambia/viper-lite"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func setEnv(t *testing.T, key, value string) func() {
+	require.NoError(t, os.Setenv(key, value))
+	return func() { _ = os.Unsetenv(key) }
+}
+
+func TestSetupEnvBound(t *testing.T) {
+	defer setEnv(t, "CENTRIFUGO_CLIENT_PRESENCE_PING_INTERVAL", "7s")()
+	v := viper.New()
+	setupEnv(v)
+	require.Equal(t, 7*time.Second, v.GetDuration("client_presence_ping_interval"))
+}
+
+func TestSetupEnvBool(t *testing.T) {
+	defer setEnv(t, "CENTRIFUGO_CLIENT_INSECURE", "true")()
+	v := viper.New()
+	v.SetDefault("client_insecure", false)
+	setupEnv(v)
+	require.True(t, v.GetBool("client_insecure"))
+}
+
+func TestSetupEnvNotBound(t *testing.T) {
+	const key = "test_option_not_in_bind_envs"
+	for _, env := range bindEnvs {
+		require.NotEqual(t, key, env)
+	}
+	defer setEnv(t, "CENTRIFUGO_TEST_OPTION_NOT_IN_BIND_ENVS", "42")()
+	v := viper.New()
+	setupEnv(v)
+	require.Equal(t, 42, v.GetInt(key))
+}
+
+func TestSetupEnvFlagPrecedence(t *testing.T) {
+	defer setEnv(t, "CENTRIFUGO_LOG_LEVEL", "debug")()
+	v := viper.New()
+	v.SetDefault("log_level", "info")
+	setupEnv(v)
+	require.Equal(t, "debug", v.GetString("log_level"))
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("log_level", "info", "")
+	require.NoError(t, v.BindPFlag("log_level", cmd.Flags().Lookup("log_level")))
+	// Flag default does not override env.
+	require.Equal(t, "debug", v.GetString("log_level"))
+	require.NoError(t, cmd.Flags().Set("log_level", "error"))
+	require.Equal(t, "error", v.GetString("log_level"))
+}
+
+func httpConfig(values map[string]interface{}) *viper.Viper {
+	v := viper.New()
+	v.Set("port", "8000")
+	v.Set("admin", true)
+	v.Set("prometheus", true)
+	for key, value := range values {
+		v.Set(key, value)
+	}
+	return v
+}
+
+func TestHTTPHandlerAddrsShared(t *testing.T) {
+	addrs, externalAddr, clientAddr := httpHandlerAddrs(httpConfig(nil))
+	require.Equal(t, ":8000", externalAddr)
+	require.Equal(t, ":8000", clientAddr)
+	require.Equal(t, map[string]HandlerFlag{
+		":8000": HandlerWebsocket | HandlerSockJS | HandlerAPI | HandlerAdmin | HandlerPrometheus,
+	}, addrs)
+}
+
+func TestHTTPHandlerAddrsInternalPort(t *testing.T) {
+	addrs, _, _ := httpHandlerAddrs(httpConfig(map[string]interface{}{
+		"internal_port": "9000",
+	}))
+	require.Equal(t, map[string]HandlerFlag{
+		":8000": HandlerWebsocket | HandlerSockJS,
+		":9000": HandlerAPI | HandlerAdmin | HandlerPrometheus,
+	}, addrs)
+}
+
+func TestHTTPHandlerAddrsSeparate(t *testing.T) {
+	addrs, externalAddr, clientAddr := httpHandlerAddrs(httpConfig(map[string]interface{}{
+		"client_addr": "127.0.0.1:8001",
+		"api_addr":    "127.0.0.1:8002",
+		"admin_addr":  "127.0.0.1:8003",
+	}))
+	require.Equal(t, ":8000", externalAddr)
+	require.Equal(t, "127.0.0.1:8001", clientAddr)
+	require.Equal(t, map[string]HandlerFlag{
+		"127.0.0.1:8001": HandlerWebsocket | HandlerSockJS,
+		"127.0.0.1:8002": HandlerAPI,
+		"127.0.0.1:8003": HandlerAdmin,
+		":8000":          HandlerPrometheus,
+	}, addrs)
+}
+
+func TestHTTPHandlerAddrsSharedDedicated(t *testing.T) {
+	addrs, _, _ := httpHandlerAddrs(httpConfig(map[string]interface{}{
+		"api_addr":       "127.0.0.1:8002",
+		"admin_addr":     "127.0.0.1:8002",
+		"sockjs_disable": true,
+	}))
+	require.Equal(t, map[string]HandlerFlag{
+		":8000":          HandlerWebsocket | HandlerPrometheus,
+		"127.0.0.1:8002": HandlerAPI | HandlerAdmin,
+	}, addrs)
+}
+
+func TestHTTPHandlerAddrsAdminExternal(t *testing.T) {
+	addrs, _, _ := httpHandlerAddrs(httpConfig(map[string]interface{}{
+		"internal_port":  "9000",
+		"admin_external": true,
+	}))
+	require.Equal(t, map[string]HandlerFlag{
+		":8000": HandlerWebsocket | HandlerSockJS | HandlerAdmin,
+		":9000": HandlerAPI | HandlerPrometheus,
+	}, addrs)
+}
+
+func TestReloadConfig(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	setDefaults(viper.GetViper())
+
+	dir, err := ioutil.TempDir("", "centrifugo_reload")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	f := filepath.Join(dir, "config.json")
+	newViper := func() *viper.Viper {
+		v := viper.New()
+		setDefaults(v)
+		return v
+	}
+
+	require.NoError(t, ioutil.WriteFile(f, []byte(`{"broadcast_workers": 2}`), 0644))
+	require.NoError(t, validateConfig(viper.GetViper(), f))
+	require.Equal(t, 2, viper.GetInt("broadcast_workers"))
+
+	// Rejected configuration must not be loaded into global viper.
+	require.NoError(t, ioutil.WriteFile(f, []byte(`{"broadcast_workers": -1, "history_size": 10}`), 0644))
+	_, _, err = reloadConfig(f, newViper)
+	require.Error(t, err)
+	require.Equal(t, 2, viper.GetInt("broadcast_workers"))
+	require.Equal(t, 0, viper.GetInt("history_size"))
+
+	require.NoError(t, ioutil.WriteFile(f, []byte(`{"broadcast_workers": 4, "history_size": 10}`), 0644))
+	v, ruleConfig, err := reloadConfig(f, newViper)
+	require.NoError(t, err)
+	require.Equal(t, 4, v.GetInt("broadcast_workers"))
+	require.Equal(t, 10, ruleConfig.HistorySize)
+	require.Equal(t, 4, viper.GetInt("broadcast_workers"))
+}
+
+func TestReloadConfigDir(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	setDefaults(viper.GetViper())
+
+	dir, err := ioutil.TempDir("", "centrifugo_reload")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	fragments := filepath.Join(dir, "namespaces")
+	require.NoError(t, os.Mkdir(fragments, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(fragments, "chat.json"), []byte(`{"namespaces": [{"name": "chat"}]}`), 0644))
+	f := filepath.Join(dir, "config.json")
+	require.NoError(t, ioutil.WriteFile(f, []byte(`{"config_dir": "`+fragments+`"}`), 0644))
+	newViper := func() *viper.Viper {
+		v := viper.New()
+		setDefaults(v)
+		return v
+	}
+
+	_, ruleConfig, err := reloadConfig(f, newViper)
+	require.NoError(t, err)
+	require.Len(t, ruleConfig.Namespaces, 1)
+	require.Equal(t, "chat", ruleConfig.Namespaces[0].Name)
+
+	// Broken namespaces and fragments are reload errors, not fatal ones.
+	require.NoError(t, ioutil.WriteFile(f, []byte(`{"namespaces": 1}`), 0644))
+	_, _, err = reloadConfig(f, newViper)
+	require.Error(t, err)
+	require.NoError(t, ioutil.WriteFile(f, []byte(`{"config_dir": "`+fragments+`"}`), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(fragments, "chat.json"), []byte(`{"namespaces": [`), 0644))
+	_, _, err = reloadConfig(f, newViper)
+	require.Error(t, err)
+	require.NoError(t, os.RemoveAll(fragments))
+	_, _, err = reloadConfig(f, newViper)
+	require.Error(t, err)
+}
+
+func TestTokenPublicKeysMalformed(t *testing.T) {
+	malformed := "-----BEGIN PUBLIC KEY-----\nbroken\n-----END PUBLIC KEY-----"
+	for _, key := range []string{"token_rsa_public_key", "token_ecdsa_public_key"} {
+		t.Run(key, func(t *testing.T) {
+			v := viper.New()
+			v.Set(key, malformed)
+			rsaKey, ecdsaKey, err := tokenPublicKeys(v)
+			require.Error(t, err)
+			require.Nil(t, rsaKey)
+			require.Nil(t, ecdsaKey)
+		})
+	}
+}
+
+func TestTokenPublicKeysNotSet(t *testing.T) {
+	rsaKey, ecdsaKey, err := tokenPublicKeys(viper.New())
+	require.NoError(t, err)
+	require.Nil(t, rsaKey)
+	require.Nil(t, ecdsaKey)
+}
+
+func TestValidateConfigBadNamespace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrifugo_validate")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	testCases := []struct {
+		name      string
+		namespace string
+		config    string
+	}{
+		{"wrong_name", "bad namespace", `{"namespaces": [{"name": "bad namespace"}]}`},
+		{"no_history", "chat", `{"namespaces": [{"name": "chat", "history_recover": true}]}`},
+		{"negative_expire", "chat", `{"namespaces": [{"name": "chat", "presence_expire_interval": -1}]}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := filepath.Join(dir, tc.name+".json")
+			require.NoError(t, ioutil.WriteFile(f, []byte(tc.config), 0644))
+			v := viper.New()
+			setDefaults(v)
+			err := validateConfig(v, f)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.namespace)
+		})
+	}
+}
+
+func TestValidateConfigNodeOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrifugo_validate")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	testCases := []struct {
+		name   string
+		config string
+	}{
+		{"engine_publish_failure_policy", `{"engine_publish_failure_policy": "retry"}`},
+		{"control_unknown_policy", `{"control_unknown_policy": "unknown"}`},
+		{"node_channel_limit", `{"node_channel_limit": -1}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := filepath.Join(dir, tc.name+".json")
+			require.NoError(t, ioutil.WriteFile(f, []byte(tc.config), 0644))
+			v := viper.New()
+			setDefaults(v)
+			require.Error(t, validateConfig(v, f))
+		})
+	}
+}
+
+func TestAdminUsersFromConfig(t *testing.T) {
+	v := viper.New()
+	v.Set("admin_users", `[{"username": "alice", "password": "secret"}, {"username": "bob", "password": "secret", "role": "full"}]`)
+	users, err := adminUsersFromConfig(v)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	require.Equal(t, admin.RoleReadOnly, users[0].Role)
+	require.Equal(t, admin.RoleFull, users[1].Role)
+
+	testCases := []struct {
+		name  string
+		users string
+	}{
+		{"malformed", `{"username": "alice"}`},
+		{"no_username", `[{"password": "secret"}]`},
+		{"duplicate", `[{"username": "alice", "password": "secret"}, {"username": "alice", "password": "secret"}]`},
+		{"no_password", `[{"username": "alice"}]`},
+		{"password_file", `[{"username": "alice", "password_file": "/nonexistent/password"}]`},
+		{"unknown_role", `[{"username": "alice", "password": "secret", "role": "unknown"}]`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := viper.New()
+			v.Set("admin_users", tc.users)
+			_, err := adminUsersFromConfig(v)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCheckSecretReuse(t *testing.T) {
+	v := viper.New()
+	v.Set("api_key", "secret")
+	v.Set("admin_password", "secret")
+	require.NoError(t, checkSecretReuse(v))
+	v.Set("forbid_secret_reuse", true)
+	require.Error(t, checkSecretReuse(v))
+
+	// Malformed admin users is an error, not a fatal one.
+	v = viper.New()
+	v.Set("admin_users", `[{"username": "alice"}]`)
+	require.Error(t, checkSecretReuse(v))
+}
+
+func TestReloadConfigAdminUsers(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	setDefaults(viper.GetViper())
+
+	dir, err := ioutil.TempDir("", "centrifugo_reload")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	f := filepath.Join(dir, "config.json")
+	newViper := func() *viper.Viper {
+		v := viper.New()
+		setDefaults(v)
+		return v
+	}
+
+	require.NoError(t, ioutil.WriteFile(f, []byte(`{"admin_users": [{"username": "alice", "password": "secret"}, {"username": "alice", "password": "other"}]}`), 0644))
+	_, _, err = reloadConfig(f, newViper)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "duplicate admin user")
+}
+
+func TestEngineRoutesFromConfig(t *testing.T) {
+	v := viper.New()
+	v.Set("engine_routes", `[{"prefix": "memory:", "engine": "memory"}]`)
+	routes, err := engineRoutesFromConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, []engineRoute{{Prefix: "memory:", Engine: "memory"}}, routes)
+
+	v.Set("engine_routes", `{"prefix": "memory:"}`)
+	_, err = engineRoutesFromConfig(v)
+	require.Error(t, err)
+	v.Set("engine_routes", `[{"engine": "memory"}]`)
+	_, err = engineRoutesFromConfig(v)
+	require.Error(t, err)
+}
+
+func TestConfigDumpError(t *testing.T) {
+	testCases := []struct {
+		name  string
+		key   string
+		value string
+	}{
+		{"admin_users", "admin_users", `[{"username": "alice"}]`},
+		{"engine_routes", "engine_routes", `[{"engine": "memory"}]`},
+		{"namespaces", "namespaces", `{"name": "chat"}`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			viper.Reset()
+			defer viper.Reset()
+			setDefaults(viper.GetViper())
+			viper.Set(tc.key, tc.value)
+			_, err := configDump()
+			require.Error(t, err)
+		})
+	}
+}